@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	grpcauth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// lifetime of the token minted for a call made with an API key
+const apiKeyTokenTTL = 5 * time.Minute
+
+// apiKeyAuthenticator authenticates callers using API keys as bearer tokens
+type apiKeyAuthenticator struct {
+	AuthAPI *grpcauth.API
+	SQLDB   *gorm.DB
+	Logger  grpclog.LoggerV2
+}
+
+// bearerFromMD returns the bearer token of the call
+func bearerFromMD(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(grpcauth.Header()) {
+		if len(v) > len(grpcauth.Scheme())+1 && strings.EqualFold(v[:len(grpcauth.Scheme())], grpcauth.Scheme()) {
+			return strings.TrimSpace(v[len(grpcauth.Scheme())+1:])
+		}
+	}
+	return ""
+}
+
+// key returns the API key of the bearer token
+func (a *apiKeyAuthenticator) key(ctx context.Context, token string) (*b2c_app_v1.APIKey, error) {
+	key, err := b2c_app_v1.AuthenticateAPIKey(ctx, a.SQLDB, token)
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			a.Logger.Errorln(err)
+			return nil, status.Error(codes.Internal, "could not complete the request")
+		}
+		return nil, err
+	}
+
+	// Keys in admin groups would bypass authorization
+	if a.AuthAPI.IsAdmin(key.Group) {
+		a.Logger.Warningf("rejected api key %s in admin group %s", key.KeyID, key.Group)
+		return nil, status.Error(codes.PermissionDenied, "api keys may not be used by admin groups")
+	}
+
+	// A missed last used time does not stop the call
+	err = b2c_app_v1.TouchAPIKey(ctx, a.SQLDB, key)
+	if err != nil {
+		a.Logger.Warningf("api key %s: %v", key.KeyID, err)
+	}
+
+	return key, nil
+}
+
+// Authenticate authenticates a call made with an API key.
+//
+// Handlers read the caller from JWT claims, so a short lived token is minted with the payload of the key
+// and authenticated in place of the key. The rule of the key is kept in the context for authorization.
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, token string) (context.Context, error) {
+	key, err := a.key(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	expires := time.Now().Add(apiKeyTokenTTL)
+	if key.ExpiresAt.Valid && key.ExpiresAt.Time.Before(expires) {
+		expires = key.ExpiresAt.Time
+	}
+
+	jwt, err := a.AuthAPI.GenToken(ctx, key.Payload(), expires)
+	if err != nil {
+		a.Logger.Errorf("failed to mint token for api key %s: %v", key.KeyID, err)
+		return nil, status.Error(codes.Internal, "could not complete the request")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(grpcauth.Header(), fmt.Sprintf("%s %s", grpcauth.Scheme(), jwt))
+
+	ctx, err = a.AuthAPI.Authenticator(metadata.NewIncomingContext(ctx, md))
+	if err != nil {
+		return nil, err
+	}
+
+	return rbac.WithKeyRule(ctx, key.Rule()), nil
+}
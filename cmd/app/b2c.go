@@ -13,11 +13,16 @@ import (
 
 	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
 	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	"github.com/gidyon/mpesa-b2c/internal/shutdown"
+	"github.com/gidyon/mpesa-b2c/internal/tracing"
 	b2c_v1 "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
-	"github.com/gidyon/mpesa-b2c/pkg/utils/httputils"
 	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/grpclog"
-	"google.golang.org/grpc/metadata"
 	"gorm.io/gorm"
 
 	"github.com/gidyon/mpesa-b2c/pkg/payload"
@@ -25,11 +30,17 @@ import (
 )
 
 type Options struct {
-	SQLDB    *gorm.DB
-	RedisDB  *redis.Client
-	Logger   grpclog.LoggerV2
-	AuthAPI  *auth.API
-	B2CV1API b2c_v1.B2CV1Server
+	SQLDB      *gorm.DB
+	RedisDB    *redis.Client
+	Logger     grpclog.LoggerV2
+	AuthAPI    *auth.API
+	B2CV1API   b2c_v1.B2CV1Server
+	ChangeFeed *b2c_app_v1.ChangeFeed
+	Shutdown   *shutdown.Coordinator
+	PIIPolicy  *b2c_app_v1.PIIPolicy
+	Authorizer *rbac.Authorizer
+	// Authenticate authenticates requests to http gateways the same way grpc calls are authenticated
+	Authenticate func(context.Context) (context.Context, error)
 }
 
 func validateOptions(opt *Options) error {
@@ -47,13 +58,23 @@ func validateOptions(opt *Options) error {
 		err = errors.New("missing auth API")
 	case opt.B2CV1API == nil:
 		err = errors.New("missing b2c v1 API")
+	case opt.ChangeFeed == nil:
+		err = errors.New("missing change feed")
+	case opt.Shutdown == nil:
+		err = errors.New("missing shutdown coordinator")
+	case opt.PIIPolicy == nil:
+		err = errors.New("missing pii policy")
+	case opt.Authorizer == nil:
+		err = errors.New("missing authorizer")
+	case opt.Authenticate == nil:
+		err = errors.New("missing authenticate func")
 	}
 	return err
 }
 
 type b2cGateway struct {
 	*Options
-	ctxExt context.Context
+	emitter b2c_app_v1.EventEmitter
 }
 
 // NewB2CGateway creates a b2c gateway for receiving b2cPayloads
@@ -63,31 +84,23 @@ func NewB2CGateway(ctx context.Context, opt *Options) (*b2cGateway, error) {
 		return nil, err
 	}
 
-	gw := &b2cGateway{
-		Options: opt,
-	}
-
-	// Generate token
-	token, err := gw.AuthAPI.GenToken(
-		ctx, &auth.Payload{Group: auth.DefaultAdminGroup()}, time.Now().Add(10*365*24*time.Hour))
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate auth token: %v", err)
+	emitter, ok := opt.B2CV1API.(b2c_app_v1.EventEmitter)
+	if !ok {
+		return nil, errors.New("b2c v1 API does not emit payment events")
 	}
 
-	md := metadata.Pairs(auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), token))
-
-	ctxExt := metadata.NewIncomingContext(ctx, md)
-
-	// Authenticate the token
-	gw.ctxExt, err = gw.AuthAPI.Authenticator(ctxExt)
-	if err != nil {
-		return nil, err
+	gw := &b2cGateway{
+		Options: opt,
+		emitter: emitter,
 	}
 
 	return gw, nil
 }
 
 func (gw *b2cGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Results of submitted transfers are processed even while draining
+	defer gw.Shutdown.Track(shutdown.KindCallback, "result "+r.URL.Path)()
+
 	code, err := gw.fromSaf(w, r)
 	if err != nil {
 		gw.Logger.Errorln("Incoming B2C failed: %s", err)
@@ -98,8 +111,6 @@ func (gw *b2cGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 func (gw *b2cGateway) fromSaf(w http.ResponseWriter, r *http.Request) (int, error) {
 
-	httputils.DumpRequest(r, "Incoming Mpesa B2C Payload V1")
-
 	if r.Method != http.MethodPost {
 		return http.StatusBadRequest, fmt.Errorf("bad method; only POST allowed; received %v method", r.Method)
 	}
@@ -110,6 +121,7 @@ func (gw *b2cGateway) fromSaf(w http.ResponseWriter, r *http.Request) (int, erro
 		db         = &b2c_app_v1.Payment{}
 		succeeded  = "YES"
 		status     = b2c_v1.B2CStatus_B2C_SUCCESS.String()
+		settled    = true
 		err        error
 	)
 
@@ -151,55 +163,113 @@ func (gw *b2cGateway) fromSaf(w http.ResponseWriter, r *http.Request) (int, erro
 	res, err := gw.RedisDB.Get(ctx, b2c_app_v1.GetMpesaRequestKey(b2cPayload.ConversationID())).Result()
 	switch {
 	case err == nil:
-		err = proto.Unmarshal([]byte(res), tranferReq)
+		bs, err := pii.Open([]byte(res))
+		if err != nil {
+			gw.Logger.Errorln("Failed to decrypt transfer funds request: ", err)
+			break
+		}
+		err = proto.Unmarshal(bs, tranferReq)
 		if err != nil {
 			gw.Logger.Errorln("Failed to unmarshal transfer funds request: ", err)
 		}
 	}
 
-	err = gw.SQLDB.First(db, "conversation_id = ?", b2cPayload.ConversationID()).Error
+	err = gw.SQLDB.WithContext(ctx).First(db, "conversation_id = ?", b2cPayload.ConversationID()).Error
+
+	// The result arrives in a new trace; link it to the trace of the transfer request
+	ctx, span := tracing.Tracer().Start(ctx, "b2c.ProcessCallback",
+		trace.WithLinks(tracing.LinkFromTraceParent(db.TraceParent)...),
+		trace.WithAttributes(
+			attribute.String("b2c.conversation_id", b2cPayload.ConversationID()),
+			attribute.Int("b2c.result_code", b2cPayload.Result.ResultCode),
+		),
+	)
+	defer span.End()
+
+	// Only successful results carry account balances
+	var (
+		chargesPaidFunds = float32(b2cPayload.B2CChargesPaidAccountAvailableFunds())
+		mpesaCharge      float32
+	)
+	if succeeded == "YES" {
+		var chargeErr error
+		mpesaCharge, chargeErr = b2c_app_v1.MpesaCharge(
+			ctx, gw.SQLDB, firstVal(db.OrgShortCode, tranferReq.GetShortCode()),
+			b2cPayload.TransactionCompletedDateTime().UTC(), chargesPaidFunds,
+		)
+		if chargeErr != nil {
+			gw.Logger.Warningf("charge of payment %s is unknown: %v", b2cPayload.ConversationID(), chargeErr)
+		}
+	}
+
 	switch {
 	case err == nil:
-		// Update STK b2cPayload
-		err = gw.SQLDB.Model(db).
-			Updates(map[string]interface{}{
-				"result_code":           fmt.Sprint(b2cPayload.Result.ResultCode),
-				"result_description":    b2cPayload.Result.ResultDesc,
-				"working_account_funds": float32(b2cPayload.B2CWorkingAccountAvailableFunds()),
-				"utility_account_funds": float32(b2cPayload.B2CUtilityAccountAvailableFunds()),
-				"mpesa_charges":         float32(b2cPayload.B2CChargesPaidAccountAvailableFunds()),
-				"recipient_registered":  b2cPayload.B2CRecipientIsRegisteredCustomer(),
-				"mpesa_receipt_id":      b2cPayload.TransactionReceipt(),
-				"transaction_time":      sql.NullTime{Valid: true, Time: b2cPayload.TransactionCompletedDateTime().UTC()},
-				"receiver_public_name":  b2cPayload.ReceiverPartyPublicName(),
-				"b2c_status":            status,
-				"succeeded":             succeeded,
-			}).Error
+		// Payments saved before publish preferences were stored rely on the cached request
+		if db.PublishInfo == "" && tranferReq.Publish {
+			err = b2c_app_v1.SetPublishInfo(db, tranferReq.Publish, tranferReq.PublishMessage)
+			if err != nil {
+				gw.Logger.Errorln(err)
+			}
+		}
+
+		// Map updates skip the model serializers
+		receiverName, err := pii.Encrypt(b2cPayload.ReceiverPartyPublicName())
 		if err != nil {
-			return http.StatusInternalServerError, fmt.Errorf("failed to update b2c: %v", err)
+			return http.StatusInternalServerError, fmt.Errorf("failed to encrypt receiver name: %v", err)
 		}
+
+		// Late or repeated results must not settle payments again, such as those being reversed
+		tx := gw.SQLDB.WithContext(ctx).Model(db).
+			Where("b2c_status IN ?", []string{
+				b2c_v1.B2CStatus_B2C_STATUS_UNKNOWN.String(),
+				b2c_v1.B2CStatus_B2C_REQUEST_FAILED.String(),
+				b2c_v1.B2CStatus_B2C_REQUEST_SUBMITED.String(),
+				b2c_v1.B2CStatus_B2C_TIMED_OUT.String(),
+			}).
+			Updates(map[string]interface{}{
+				"publish":                    db.Publish,
+				"publish_info":               db.PublishInfo,
+				"result_code":                fmt.Sprint(b2cPayload.Result.ResultCode),
+				"result_description":         b2cPayload.Result.ResultDesc,
+				"working_account_funds":      float32(b2cPayload.B2CWorkingAccountAvailableFunds()),
+				"utility_account_funds":      float32(b2cPayload.B2CUtilityAccountAvailableFunds()),
+				"charges_paid_account_funds": chargesPaidFunds,
+				"mpesa_charges":              mpesaCharge,
+				"recipient_registered":       b2cPayload.B2CRecipientIsRegisteredCustomer(),
+				"mpesa_receipt_id":           b2cPayload.TransactionReceipt(),
+				"transaction_time":           sql.NullTime{Valid: true, Time: b2cPayload.TransactionCompletedDateTime().UTC()},
+				"receiver_public_name":       receiverName,
+				"b2c_status":                 status,
+				"succeeded":                  succeeded,
+			})
+		if tx.Error != nil {
+			return http.StatusInternalServerError, fmt.Errorf("failed to update b2c: %v", tx.Error)
+		}
+		settled = tx.RowsAffected == 1
 	case errors.Is(err, gorm.ErrRecordNotFound):
 		// Create B2C
 		db = &b2c_app_v1.Payment{
-			ID:                         0,
-			InitiatorID:                tranferReq.GetInitiatorId(),
-			InitiatorCustomerReference: tranferReq.GetInitiatorCustomerReference(),
-			InitiatorCustomerNames:     tranferReq.GetInitiatorCustomerNames(),
-			Msisdn:                     b2cPayload.MSISDN(),
-			OrgShortCode:               tranferReq.ShortCode,
-			CommandId:                  tranferReq.CommandId.String(),
-			TransactionAmount:          float32(b2cPayload.TransactionAmount()),
-			ConversationID:             b2cPayload.ConversationID(),
-			OriginatorConversationID:   b2cPayload.OriginatorConversationID(),
-			ResponseDescription:        "",
-			ResponseCode:               "",
-			ResultCode:                 fmt.Sprint(b2cPayload.Result.ResultCode),
-			ResultDescription:          b2cPayload.Result.ResultDesc,
-			WorkingAccountFunds:        float32(b2cPayload.B2CWorkingAccountAvailableFunds()),
-			UtilityAccountFunds:        float32(b2cPayload.B2CUtilityAccountAvailableFunds()),
-			MpesaCharges:               float32(b2cPayload.B2CChargesPaidAccountAvailableFunds()),
-			SystemCharges:              0,
-			RecipientRegistered:        b2cPayload.B2CRecipientIsRegisteredCustomer(),
+			ID:                            0,
+			InitiatorID:                   tranferReq.GetInitiatorId(),
+			InitiatorCustomerReference:    tranferReq.GetInitiatorCustomerReference(),
+			InitiatorCustomerNames:        tranferReq.GetInitiatorCustomerNames(),
+			InitiatorTransactionReference: tranferReq.GetInitiatorTransactionReference(),
+			Msisdn:                        b2cPayload.MSISDN(),
+			OrgShortCode:                  tranferReq.ShortCode,
+			CommandId:                     tranferReq.CommandId.String(),
+			TransactionAmount:             float32(b2cPayload.TransactionAmount()),
+			ConversationID:                b2cPayload.ConversationID(),
+			OriginatorConversationID:      b2cPayload.OriginatorConversationID(),
+			ResponseDescription:           "",
+			ResponseCode:                  "",
+			ResultCode:                    fmt.Sprint(b2cPayload.Result.ResultCode),
+			ResultDescription:             b2cPayload.Result.ResultDesc,
+			WorkingAccountFunds:           float32(b2cPayload.B2CWorkingAccountAvailableFunds()),
+			UtilityAccountFunds:           float32(b2cPayload.B2CUtilityAccountAvailableFunds()),
+			ChargesPaidAccountFunds:       chargesPaidFunds,
+			MpesaCharges:                  mpesaCharge,
+			SystemCharges:                 0,
+			RecipientRegistered:           b2cPayload.B2CRecipientIsRegisteredCustomer(),
 			MpesaReceiptId: sql.NullString{
 				Valid:  b2cPayload.TransactionReceipt() != "",
 				String: b2cPayload.TransactionReceipt(),
@@ -213,7 +283,11 @@ func (gw *b2cGateway) fromSaf(w http.ResponseWriter, r *http.Request) (int, erro
 			TransactionTime:    sql.NullTime{Valid: true, Time: b2cPayload.TransactionCompletedDateTime().UTC()},
 			CreatedAt:          time.Time{},
 		}
-		err = gw.SQLDB.Create(db).Error
+		err = b2c_app_v1.SetPublishInfo(db, tranferReq.Publish, tranferReq.PublishMessage)
+		if err != nil {
+			gw.Logger.Errorln(err)
+		}
+		err = gw.SQLDB.WithContext(ctx).Create(db).Error
 		if err != nil {
 			return http.StatusInternalServerError, fmt.Errorf("failed to create b2c b2cPayload: %v", err)
 		}
@@ -222,41 +296,181 @@ func (gw *b2cGateway) fromSaf(w http.ResponseWriter, r *http.Request) (int, erro
 		return http.StatusInternalServerError, errors.New("failed to create b2c b2cPayload")
 	}
 
-	pb, err := b2c_app_v1.PaymentProto(db)
+	metrics.Callbacks.WithLabelValues(db.OrgShortCode, fmt.Sprint(b2cPayload.Result.ResultCode)).Inc()
+
+	// Successful results carry the account balances after the transfer
+	if succeeded == "YES" {
+		metrics.AccountBalance.WithLabelValues(db.OrgShortCode, "working").Set(b2cPayload.B2CWorkingAccountAvailableFunds())
+		metrics.AccountBalance.WithLabelValues(db.OrgShortCode, "utility").Set(b2cPayload.B2CUtilityAccountAvailableFunds())
+	}
+
+	if settled {
+		eventType := b2c_v1.B2CEventType_B2C_PAYMENT_SUCCEEDED
+		if succeeded != "YES" {
+			eventType = b2c_v1.B2CEventType_B2C_PAYMENT_FAILED
+		}
+		gw.emitter.EmitPaymentEvent(ctx, eventType, db)
+	}
+
+	_, err = w.Write([]byte("mpesa b2c b2cPayload processed"))
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// ServeTimeout handles requests that timed out in the mpesa queue
+func (gw *b2cGateway) ServeTimeout(w http.ResponseWriter, r *http.Request) {
+	defer gw.Shutdown.Track(shutdown.KindCallback, "timeout "+r.URL.Path)()
+
+	code, err := gw.timeout(w, r)
+	if err != nil {
+		gw.Logger.Errorf("Incoming B2C timeout failed: %s", err)
+		http.Error(w, "request handler failed", code)
+		return
+	}
+}
+
+func (gw *b2cGateway) timeout(w http.ResponseWriter, r *http.Request) (int, error) {
+
+	if r.Method != http.MethodPost {
+		return http.StatusBadRequest, fmt.Errorf("bad method; only POST allowed; received %v method", r.Method)
+	}
+
+	b2cPayload := &payload.Transaction{}
+
+	err := json.NewDecoder(r.Body).Decode(b2cPayload)
 	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("decoding json failed: %w", err)
+	}
+
+	switch {
+	case b2cPayload.ConversationID() == "" && b2cPayload.OriginatorConversationID() == "":
+		return http.StatusBadRequest, fmt.Errorf("missing conversation id")
+	}
+
+	ctx := r.Context()
+
+	// Only a given id is matched as payments that failed submission have empty ids
+	db := &b2c_app_v1.Payment{}
+	if b2cPayload.ConversationID() != "" {
+		err = gw.SQLDB.WithContext(ctx).First(db, "conversation_id = ?", b2cPayload.ConversationID()).Error
+	} else {
+		err = gw.SQLDB.WithContext(ctx).First(db, "originator_conversation_id = ?", b2cPayload.OriginatorConversationID()).Error
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound, fmt.Errorf(
+			"payment with conversation id %q or originator conversation id %q not found",
+			b2cPayload.ConversationID(), b2cPayload.OriginatorConversationID(),
+		)
+	default:
 		gw.Logger.Errorln(err)
-		return http.StatusInternalServerError, errors.New("failed to get b2c proto")
+		return http.StatusInternalServerError, errors.New("failed to get timed out payment")
 	}
 
-	// Publish the transaction
-	if tranferReq.Publish {
-		publish := func() {
-			_, err = gw.B2CV1API.PublishB2CPayment(gw.ctxExt, &b2c_v1.PublishB2CPaymentRequest{
-				PublishMessage: &b2c_v1.PublishMessage{
-					InitiatorId:    tranferReq.InitiatorId,
-					TransactionId:  pb.TransactionId,
-					MpesaReceiptId: pb.MpesaReceiptId,
-					Msisdn:         b2cPayload.MSISDN(),
-					PublishInfo:    tranferReq.PublishMessage,
-					Payment:        pb,
-				},
+	// Results that arrived before the timeout take precedence, including those racing this update
+	if db.B2CStatus == b2c_v1.B2CStatus_B2C_REQUEST_SUBMITED.String() {
+		tx := gw.SQLDB.WithContext(ctx).Model(db).
+			Where("b2c_status = ?", b2c_v1.B2CStatus_B2C_REQUEST_SUBMITED.String()).
+			Updates(map[string]interface{}{
+				"result_code":        fmt.Sprint(b2cPayload.Result.ResultCode),
+				"result_description": firstVal(b2cPayload.Result.ResultDesc, "request timed out in mpesa queue"),
+				"b2c_status":         b2c_v1.B2CStatus_B2C_TIMED_OUT.String(),
+				"succeeded":          "NO",
 			})
-			if err != nil {
-				gw.Logger.Warningf("failed to publish message: %v", err)
-			} else {
-				gw.Logger.Infoln("B2C has been published on channel ", tranferReq.GetPublishMessage().GetChannelName())
-			}
+		if tx.Error != nil {
+			return http.StatusInternalServerError, fmt.Errorf("failed to update timed out b2c: %v", tx.Error)
 		}
-		if tranferReq.GetPublishMessage().GetOnlyOnSuccess() {
-			if pb.Succeeded {
-				publish()
+
+		if tx.RowsAffected == 1 {
+			gw.emitter.EmitPaymentEvent(ctx, b2c_v1.B2CEventType_B2C_PAYMENT_TIMED_OUT, db)
+		}
+	}
+
+	_, err = w.Write([]byte("mpesa b2c timeout processed"))
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	return http.StatusOK, nil
+}
+
+// ServeReversal handles results of transaction reversals
+func (gw *b2cGateway) ServeReversal(w http.ResponseWriter, r *http.Request) {
+	defer gw.Shutdown.Track(shutdown.KindCallback, "reversal "+r.URL.Path)()
+
+	code, err := gw.reversal(w, r)
+	if err != nil {
+		gw.Logger.Errorf("Incoming B2C reversal failed: %s", err)
+		http.Error(w, "request handler failed", code)
+		return
+	}
+}
+
+func (gw *b2cGateway) reversal(w http.ResponseWriter, r *http.Request) (int, error) {
+
+	if r.Method != http.MethodPost {
+		return http.StatusBadRequest, fmt.Errorf("bad method; only POST allowed; received %v method", r.Method)
+	}
+
+	reversalPayload := &payload.Transaction{}
+
+	err := json.NewDecoder(r.Body).Decode(reversalPayload)
+	if err != nil {
+		return http.StatusBadRequest, fmt.Errorf("decoding json failed: %w", err)
+	}
+
+	if reversalPayload.ConversationID() == "" {
+		return http.StatusBadRequest, fmt.Errorf("missing conversation id")
+	}
+
+	ctx := r.Context()
+
+	db := &b2c_app_v1.Payment{}
+	err = gw.SQLDB.WithContext(ctx).First(db, "reversal_conversation_id = ?", reversalPayload.ConversationID()).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return http.StatusNotFound, fmt.Errorf(
+			"payment with reversal conversation id %q not found", reversalPayload.ConversationID(),
+		)
+	default:
+		gw.Logger.Errorln(err)
+		return http.StatusInternalServerError, errors.New("failed to get reversed payment")
+	}
+
+	// Repeated or concurrent results are acknowledged without changes
+	if db.B2CStatus == b2c_v1.B2CStatus_B2C_REVERSAL_PENDING.String() {
+		pending := gw.SQLDB.WithContext(ctx).Model(db).
+			Where("b2c_status = ?", b2c_v1.B2CStatus_B2C_REVERSAL_PENDING.String())
+
+		if reversalPayload.Succeeded() {
+			tx := pending.Update("b2c_status", b2c_v1.B2CStatus_B2C_REVERSED.String())
+			if tx.Error != nil {
+				return http.StatusInternalServerError, fmt.Errorf("failed to update reversed b2c: %v", tx.Error)
+			}
+
+			if tx.RowsAffected == 1 {
+				gw.emitter.EmitPaymentEvent(ctx, b2c_v1.B2CEventType_B2C_PAYMENT_REVERSED, db)
 			}
 		} else {
-			publish()
+			tx := pending.Update("b2c_status", b2c_v1.B2CStatus_B2C_SUCCESS.String())
+			if tx.Error != nil {
+				return http.StatusInternalServerError, fmt.Errorf("failed to update b2c of failed reversal: %v", tx.Error)
+			}
+
+			if tx.RowsAffected == 1 {
+				gw.Logger.Warningf(
+					"reversal of payment %d failed: %d %s", db.ID, reversalPayload.Result.ResultCode, reversalPayload.Result.ResultDesc,
+				)
+			}
 		}
 	}
 
-	_, err = w.Write([]byte("mpesa b2c b2cPayload processed"))
+	_, err = w.Write([]byte("mpesa b2c reversal processed"))
 	if err != nil {
 		return http.StatusInternalServerError, err
 	}
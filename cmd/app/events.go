@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+	b2c_v1 "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const sseHeartbeatInterval = 15 * time.Second
+
+type eventsGateway struct {
+	*Options
+}
+
+// NewEventsGateway creates a gateway that streams payment changes to browsers as server-sent events
+func NewEventsGateway(ctx context.Context, opt *Options) (*eventsGateway, error) {
+	err := validateOptions(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &eventsGateway{Options: opt}, nil
+}
+
+// reads comma separated or repeated query values
+func queryValues(r *http.Request, key string) []string {
+	vals := make([]string, 0)
+	for _, v := range r.URL.Query()[key] {
+		for _, val := range strings.Split(v, ",") {
+			if val = strings.TrimSpace(val); val != "" {
+				vals = append(vals, val)
+			}
+		}
+	}
+	return vals
+}
+
+// reads the bearer token from the authorization header.
+//
+// EventSource cannot set headers, so the token may also be passed in the access_token query parameter.
+func bearerToken(r *http.Request) string {
+	authHeader := r.Header.Get(auth.Header())
+	if len(authHeader) > len(auth.Scheme())+1 && strings.EqualFold(authHeader[:len(auth.Scheme())], auth.Scheme()) {
+		return strings.TrimSpace(authHeader[len(auth.Scheme())+1:])
+	}
+	return r.URL.Query().Get("access_token")
+}
+
+// authenticateRequest authenticates an http request through the grpc authentication, kong and API keys included.
+//
+// Headers are passed as metadata the same way the grpc gateway passes them.
+func (opt *Options) authenticateRequest(r *http.Request) (context.Context, *auth.Payload, error) {
+	token := bearerToken(r)
+	if token == "" {
+		return nil, nil, errors.New("missing bearer token")
+	}
+
+	md := metadata.MD{}
+	for key, vals := range r.Header {
+		if key, ok := debugLogHeaderMatcher(key); ok {
+			md.Append(key, vals...)
+		}
+	}
+	md.Set(auth.Header(), fmt.Sprintf("%s %s", auth.Scheme(), token))
+
+	ctx, err := opt.Authenticate(metadata.NewIncomingContext(r.Context(), md))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload, err := opt.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ctx, payload, nil
+}
+
+func (gw *eventsGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "only GET allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Authentication
+	ctx, payload, err := gw.authenticateRequest(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unauthenticated: %s", status.Convert(err).Message()), http.StatusUnauthorized)
+		return
+	}
+
+	// Authorization; changes are scoped like those of the grpc watch
+	scope, err := gw.Authorizer.Scope(ctx, payload, "WatchB2CPayments")
+	if err != nil {
+		http.Error(w, "not allowed to watch b2c payments", http.StatusForbidden)
+		return
+	}
+
+	filter := &b2c_v1.ListB2CPaymentFilter{
+		ShortCodes:   queryValues(r, "short_codes"),
+		InitiatorIds: queryValues(r, "initiator_ids"),
+	}
+
+	access := gw.PIIPolicy.Access(payload)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// Resume from the last event the client received
+	lastID := firstVal(r.Header.Get("Last-Event-ID"), r.URL.Query().Get("last_event_id"))
+	switch {
+	case lastID == "":
+		lastID, err = gw.ChangeFeed.LastID(ctx)
+		if err != nil {
+			gw.Logger.Errorln(err)
+			http.Error(w, "failed to get change feed position", http.StatusInternalServerError)
+			return
+		}
+	case !b2c_app_v1.ValidResumeToken(lastID):
+		http.Error(w, "incorrect Last-Event-ID", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// Tell clients how long to wait before reconnecting
+	fmt.Fprintf(w, "retry: %d\n\n", (5 * time.Second).Milliseconds())
+	flusher.Flush()
+
+	lastWrite := time.Now()
+
+	for {
+		changes, nextID, err := gw.ChangeFeed.Read(ctx, lastID)
+		switch {
+		case err == nil:
+		case ctx.Err() != nil:
+			return
+		default:
+			gw.Logger.Errorf("failed to read change feed: %v", err)
+			return
+		}
+		lastID = nextID
+
+		for _, change := range changes {
+			ok, _ := b2c_app_v1.PaymentMatchesFilter(filter, change.Payment)
+			if !ok || !scope.Allows(change.Payment.OrgShortCode, change.Payment.InitiatorId) {
+				continue
+			}
+
+			access.MaskPayment(change.Payment)
+
+			err = b2c_app_v1.AuditPIIAccess(ctx, gw.SQLDB, access, "WatchB2CPayments", "source=events", change.Payment.TransactionId)
+			if err != nil {
+				gw.Logger.Errorln(err)
+				return
+			}
+
+			bs, err := protojson.Marshal(change.Payment)
+			if err != nil {
+				gw.Logger.Errorf("failed to marshal payment: %v", err)
+				continue
+			}
+
+			_, err = fmt.Fprintf(w, "id: %s\nevent: payment\ndata: %s\n\n", change.ID, bs)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+			lastWrite = time.Now()
+		}
+
+		// Comments keep idle connections open through proxies
+		if time.Since(lastWrite) >= sseHeartbeatInterval {
+			_, err = fmt.Fprintf(w, ": heartbeat %d\n\n", time.Now().Unix())
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+			lastWrite = time.Now()
+		}
+	}
+}
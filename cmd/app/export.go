@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	b2c_v1 "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+type exportGateway struct {
+	*Options
+}
+
+// NewExportGateway creates a gateway for downloading exported b2c payments
+func NewExportGateway(ctx context.Context, opt *Options) (*exportGateway, error) {
+	err := validateOptions(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exportGateway{Options: opt}, nil
+}
+
+// ServeHTTP accepts the export request as query parameters on GET or as a JSON body on POST
+func (gw *exportGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Authentication
+	ctx, payload, err := gw.authenticateRequest(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unauthenticated: %s", status.Convert(err).Message()), http.StatusUnauthorized)
+		return
+	}
+
+	// Authorization
+	scope, err := gw.Authorizer.Scope(ctx, payload, "ExportB2CPayments")
+	if err != nil {
+		http.Error(w, "not allowed to export b2c payments", http.StatusForbidden)
+		return
+	}
+	ctx = rbac.WithScope(ctx, scope)
+
+	req := &b2c_v1.ExportB2CPaymentsRequest{}
+
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		query.Del("access_token")
+		err = runtime.PopulateQueryParameters(req, query, utilities.NewDoubleArray(nil))
+	case http.MethodPost:
+		var bs []byte
+		bs, err = io.ReadAll(r.Body)
+		if err == nil {
+			err = protojson.Unmarshal(bs, req)
+		}
+	default:
+		http.Error(w, "only GET and POST allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read export request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	err = b2c_app_v1.ValidateExportRequest(req)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		return
+	}
+
+	fw := &fileWriter{
+		w:           w,
+		contentType: b2c_app_v1.ExportContentType(req.Format),
+		fileName:    b2c_app_v1.ExportFileName(req.Format),
+	}
+
+	err = b2c_app_v1.ExportPayments(ctx, gw.SQLDB, req, gw.PIIPolicy.Access(payload), fw)
+	switch {
+	case err == nil:
+		// Exports without any bytes still send the file headers
+		fw.writeHeader()
+	case fw.wrote:
+		// Headers are already sent so the client sees a truncated file
+		gw.Logger.Errorf("failed to export b2c payments: %v", err)
+	case b2c_app_v1.IsExportRequestError(err):
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	default:
+		gw.Logger.Errorf("failed to export b2c payments: %v", err)
+		http.Error(w, "failed to export b2c payments", http.StatusInternalServerError)
+	}
+}
+
+// fileWriter sends the file headers with the first bytes written so that exports failing before
+// writing anything are answered with an error instead
+type fileWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	fileName    string
+	wrote       bool
+}
+
+func (fw *fileWriter) writeHeader() {
+	if fw.wrote {
+		return
+	}
+	fw.wrote = true
+	fw.w.Header().Set("Content-Type", fw.contentType)
+	fw.w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fw.fileName))
+	fw.w.WriteHeader(http.StatusOK)
+}
+
+func (fw *fileWriter) Write(p []byte) (int, error) {
+	fw.writeHeader()
+	return fw.w.Write(p)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	"github.com/spf13/viper"
+)
+
+const keyringUsage = `usage: app [-config-file .env] keyring <command> [flags]
+
+commands:
+  init                    create a keyring file with a new primary key
+  rotate                  add a new primary key to the keyring file; older keys stay to decrypt existing data
+  reencrypt [-batch n]    encrypt payments and webhook secrets under the primary key, including those stored before encryption
+
+flags:
+  -file path              keyring file; defaults to PII_KEYRING_FILE`
+
+// runKeyring runs the keyring command
+func runKeyring(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(keyringUsage)
+	}
+
+	fs := flag.NewFlagSet("keyring "+args[0], flag.ContinueOnError)
+	file := fs.String("file", viper.GetString("PII_KEYRING_FILE"), "Keyring file")
+	batch := fs.Int("batch", 500, "Number of payments read at a time")
+
+	err := fs.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("missing keyring file; set PII_KEYRING_FILE or pass -file")
+	}
+
+	switch args[0] {
+	case "init":
+		if _, err := os.Stat(*file); err == nil {
+			return fmt.Errorf("keyring file %s already exists", *file)
+		}
+		keyring, err := pii.NewKeyring()
+		if err != nil {
+			return err
+		}
+		err = keyring.Save(*file)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("created keyring %s with primary key %s\n", *file, keyring.PrimaryKeyID())
+	case "rotate":
+		keyring, err := pii.LoadKeyring(*file)
+		if err != nil {
+			return err
+		}
+		id, err := keyring.Rotate()
+		if err != nil {
+			return err
+		}
+		err = keyring.Save(*file)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("primary key is now %s; restart the service then run keyring reencrypt\n", id)
+	case "reencrypt":
+		keyring, err := pii.LoadKeyring(*file)
+		if err != nil {
+			return err
+		}
+		pii.SetKeyring(keyring)
+
+		sqlDB, err := openSQLDB()
+		if err != nil {
+			return err
+		}
+
+		n, err := b2c_app_v1.ReencryptPayments(ctx, sqlDB, *batch)
+		fmt.Printf("re-encrypted %d payments under key %s\n", n, keyring.PrimaryKeyID())
+		if err != nil {
+			return err
+		}
+
+		n, err = b2c_app_v1.ReencryptWebhookSecrets(ctx, sqlDB)
+		fmt.Printf("re-encrypted %d webhook secrets under key %s\n", n, keyring.PrimaryKeyID())
+		if err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown keyring command %q\n%s", args[0], keyringUsage)
+	}
+
+	return nil
+}
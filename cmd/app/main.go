@@ -5,7 +5,10 @@ import (
 	"errors"
 	"flag"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/gidyon/gomicro"
 	"github.com/gidyon/gomicro/pkg/conn"
@@ -14,12 +17,26 @@ import (
 	"github.com/gidyon/gomicro/utils/errs"
 	"github.com/gidyon/kongauth"
 	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/internal/database"
+	"github.com/gidyon/mpesa-b2c/internal/health"
+	"github.com/gidyon/mpesa-b2c/internal/leader"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	"github.com/gidyon/mpesa-b2c/internal/shutdown"
+	"github.com/gidyon/mpesa-b2c/internal/tracing"
 	b2c_v1 "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/httputils"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
 	"github.com/go-redis/redis/v8"
 	"github.com/rs/cors"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
+	"gorm.io/gorm"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
@@ -48,6 +65,25 @@ func main() {
 	// gRPC logger compatible
 	appLogger := zaplogger.ZapGrpcLoggerV2(zaplogger.Log)
 
+	// Keyring of encrypted personal data is managed as a command
+	if flag.Arg(0) == "keyring" {
+		errs.Panic(runKeyring(ctx, flag.Args()[1:]))
+		return
+	}
+
+	// Personal data is encrypted when a keyring is configured
+	if keyringFile := viper.GetString("PII_KEYRING_FILE"); keyringFile != "" {
+		keyring, err := pii.LoadKeyring(keyringFile)
+		errs.Panic(err)
+		pii.SetKeyring(keyring)
+	}
+
+	// Schema migrations are run as a command
+	if flag.Arg(0) == "migrate" {
+		errs.Panic(runMigrate(ctx, flag.Args()[1:]))
+		return
+	}
+
 	// New service instance
 	app, err := gomicro.NewService(&gomicro.Options{
 		ServiceName:        viper.GetString("appName"),
@@ -67,24 +103,31 @@ func main() {
 	})
 	errs.Panic(err)
 
-	// Open gorm connection
-	sqlDB, err := conn.OpenGorm(&conn.DbOptions{
-		Name:     viper.GetString("mysqlName"),
-		Dialect:  viper.GetString("mysqlDialect"),
-		Address:  viper.GetString("mysqlAddress"),
-		User:     viper.GetString("mysqlUser"),
-		Password: viper.GetString("mysqlPassword"),
-		Schema:   viper.GetString("mysqlSchema"),
-		ConnPool: &conn.DbPoolSettings{
-			MaxIdleConns: viper.GetUint("mysqlMaxIdleConns"),
-			MaxOpenConns: viper.GetUint("mysqlMaxOpenConns"),
-			MaxLifetime:  viper.GetDuration("mysqlMaxLifetime"),
-		},
+	// Tracing
+	shutdownTracing, err := tracing.Init(ctx, &tracing.Options{
+		ServiceName:  viper.GetString("appName"),
+		Exporter:     viper.GetString("TRACING_EXPORTER"),
+		OTLPEndpoint: viper.GetString("TRACING_OTLP_ENDPOINT"),
+		OTLPInsecure: viper.GetBool("TRACING_OTLP_INSECURE"),
+		SampleRatio:  viper.GetFloat64("TRACING_SAMPLE_RATIO"),
 	})
 	errs.Panic(err)
+	defer shutdownTracing(context.Background())
+
+	// Mpesa timestamps and report day boundaries are in the reporting timezone
+	reportingTimezone := firstVal(viper.GetString("REPORTING_TIMEZONE"), timeutil.DefaultReportingTimezone)
+	reportingLocation, err := time.LoadLocation(reportingTimezone)
+	errs.Panic(err)
+	timeutil.SetReportingLocation(reportingLocation)
+
+	// Open gorm connection
+	sqlDB, err := openSQLDB()
+	errs.Panic(err)
 
 	sqlDB = sqlDB.Debug()
 
+	errs.Panic(sqlDB.Use(tracing.GormPlugin{}))
+
 	// Open redis connection
 	redisDB := redis.NewClient(&redis.Options{
 		Network:      viper.GetString("redisNetwork"),
@@ -99,6 +142,81 @@ func main() {
 		MaxConnAge:   viper.GetDuration("redisMaxConnAge"),
 	})
 
+	redisDB.AddHook(tracing.RedisHook{})
+
+	// Health checks of dependencies
+	healthRegistry := health.NewRegistry(viper.GetDuration("HEALTH_CHECK_TIMEOUT"))
+
+	healthRegistry.AddReadinessCheck("database", func(ctx context.Context) (map[string]interface{}, error) {
+		db, err := sqlDB.DB()
+		if err != nil {
+			return nil, err
+		}
+		stats := db.Stats()
+		return map[string]interface{}{
+			"open_connections": stats.OpenConnections,
+			"in_use":           stats.InUse,
+			"idle":             stats.Idle,
+			"wait_count":       stats.WaitCount,
+		}, db.PingContext(ctx)
+	})
+
+	healthRegistry.AddReadinessCheck("redis", func(ctx context.Context) (map[string]interface{}, error) {
+		stats := redisDB.PoolStats()
+		return map[string]interface{}{
+			"total_connections": stats.TotalConns,
+			"idle_connections":  stats.IdleConns,
+			"timeouts":          stats.Timeouts,
+		}, redisDB.Ping(ctx).Err()
+	})
+
+	// Tracks in-flight transfers and callbacks drained on shutdown
+	shutdownCoordinator := shutdown.NewCoordinator()
+
+	healthRegistry.AddReadinessCheck("shutdown", func(context.Context) (map[string]interface{}, error) {
+		detail := map[string]interface{}{"in_flight": shutdownCoordinator.InFlight()}
+		if shutdownCoordinator.Draining() {
+			return detail, errors.New("service is shutting down")
+		}
+		return detail, nil
+	})
+
+	// Only the elected replica runs the periodic workers
+	workersElector, err := leader.NewElector(redisDB, &leader.Options{
+		Name:     firstVal(viper.GetString("LEADER_ELECTION_NAME"), "b2c-workers"),
+		LeaseTTL: viper.GetDuration("LEADER_LEASE_TTL"),
+		Logger:   appLogger,
+	})
+	errs.Panic(err)
+
+	healthRegistry.AddReadinessCheck("leader", workersElector.HealthCheck)
+
+	// Redacted logging of http traffic
+	redactRules := httputils.DefaultRedactRules()
+	redactRules.Headers = append(redactRules.Headers, viper.GetStringSlice("HTTP_LOG_REDACT_HEADERS")...)
+	redactRules.Fields = append(redactRules.Fields, viper.GetStringSlice("HTTP_LOG_REDACT_FIELDS")...)
+	redactRules.MaskMsisdn = !viper.GetBool("HTTP_LOG_SHOW_MSISDN")
+
+	httpLogOptions := &httputils.LoggerOptions{
+		Logger:       zaplogger.Log.WithOptions(zap.WithCaller(false)),
+		Rules:        redactRules,
+		SampleRate:   1,
+		LogBodies:    viper.GetBool("HTTP_LOG_BODIES"),
+		MaxBodyBytes: viper.GetInt("HTTP_LOG_MAX_BODY_BYTES"),
+		// Callbacks are not authenticated so the debug header is off unless asked for
+		AllowDebugHeader: viper.GetBool("HTTP_LOG_DEBUG_HEADER"),
+	}
+	if viper.IsSet("HTTP_LOG_SAMPLE_RATE") {
+		httpLogOptions.SampleRate = viper.GetFloat64("HTTP_LOG_SAMPLE_RATE")
+	}
+
+	// Tracing middleware
+	app.AddGRPCUnaryServerInterceptors(otelgrpc.UnaryServerInterceptor())
+	app.AddGRPCStreamServerInterceptors(otelgrpc.StreamServerInterceptor())
+	app.AddGRPCUnaryClientInterceptors(otelgrpc.UnaryClientInterceptor())
+	app.AddGRPCStreamClientInterceptors(otelgrpc.StreamClientInterceptor())
+	app.AddHTTPMiddlewares(tracing.NewHandler)
+
 	// Recovery middleware
 	recoveryUIs, recoverySIs := app_grpc_middleware.AddRecovery()
 	app.AddGRPCUnaryServerInterceptors(recoveryUIs...)
@@ -122,8 +240,18 @@ func main() {
 	// Add admins
 	authAPI.AddAdminGroups(viper.GetStringSlice("ADMIN_GROUPS")...)
 
-	// Authentication middleware
-	authUIs, authSIs := app_grpc_middleware.AddAuth(func(ctx context.Context) (context.Context, error) {
+	// API keys of service callers are accepted as bearer tokens
+	apiKeyAuth := &apiKeyAuthenticator{
+		AuthAPI: authAPI,
+		SQLDB:   sqlDB,
+		Logger:  appLogger,
+	}
+
+	// Authentication of grpc calls and http gateways
+	authenticate := func(ctx context.Context) (context.Context, error) {
+		if token := bearerFromMD(ctx); b2c_app_v1.IsAPIKey(token) {
+			return apiKeyAuth.Authenticate(ctx, token)
+		}
 		// Call custom auth implementation
 		return kongauth.Authenticator(ctx, &kongauth.AuthOptions{
 			AuthAPI: authAPI,
@@ -131,11 +259,33 @@ func main() {
 			RedisDB: redisDB,
 			Logger:  appLogger,
 		})
-	})
+	}
+
+	// Authentication middleware
+	authUIs, authSIs := app_grpc_middleware.AddAuth(authenticate)
 	app.AddGRPCUnaryServerInterceptors(authUIs...)
 	app.AddGRPCStreamServerInterceptors(authSIs...)
 
+	// Authorization of rpcs, short codes and initiators
+	authorizer := &rbac.Authorizer{
+		AuthAPI: authAPI,
+		Service: b2c_v1.B2CV1_ServiceDesc.ServiceName,
+	}
+	if policyFile := viper.GetString("RBAC_POLICY_FILE"); policyFile != "" {
+		authorizer.Policy, err = rbac.LoadPolicy(policyFile)
+		errs.Panic(err)
+		errs.Panic(authorizer.Policy.Validate(&b2c_v1.B2CV1_ServiceDesc))
+	} else {
+		appLogger.Warning("RBAC_POLICY_FILE is not set; every authenticated caller may call every rpc")
+	}
+	app.AddGRPCUnaryServerInterceptors(authorizer.UnaryInterceptor())
+	app.AddGRPCStreamServerInterceptors(authorizer.StreamInterceptor())
+
+	// Debug logging of mpesa requests made while handling a call of an admin
+	app.AddGRPCUnaryServerInterceptors(debugLogUnaryInterceptor(authAPI))
+
 	// Servemux option for JSON Marshaling
+	app.AddRuntimeMuxOptions(runtime.WithIncomingHeaderMatcher(debugLogHeaderMatcher))
 	app.AddRuntimeMuxOptions(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
 		MarshalOptions: protojson.MarshalOptions{
 			EmitUnpopulated: true,
@@ -158,6 +308,7 @@ func main() {
 			"Origin",
 			"User-Agent",
 			"X-Requested-With",
+			httputils.DebugHeader,
 		},
 		ExposedHeaders:       []string{"Authorization"},
 		MaxAge:               1728,
@@ -176,10 +327,28 @@ func main() {
 
 	// Start the service
 	app.Start(ctx, func() error {
+		// Workers stop once in-flight work is drained on shutdown
+		workerCtx, stopWorkers := context.WithCancel(ctx)
+
+		go workersElector.Run(workerCtx)
+
 		var b2cCallbackV1 = firstVal(viper.GetString("B2C_RESULT_URL"))
 
+		// Feed of payment changes shared by all replicas
+		changeFeed := b2c_app_v1.NewChangeFeed(redisDB, viper.GetInt64("CHANGE_FEED_MAX_LEN"), appLogger)
+
+		// Archival of old payments
+		archiveTarget, err := b2c_app_v1.ParseArchiveTarget(viper.GetString("ARCHIVE_TARGET"))
+		errs.Panic(err)
+
+		// Groups other than admins that see msisdns and names unmasked
+		piiPolicy := &b2c_app_v1.PIIPolicy{
+			AuthAPI:        authAPI,
+			UnmaskedGroups: viper.GetStringSlice("PII_UNMASKED_GROUPS"),
+		}
+
 		// B2C V1
-		b2cV1, err := b2c_app_v1.NewB2CAPI(ctx, &b2c_app_v1.Options{
+		b2cV1, err := b2c_app_v1.NewB2CAPI(workerCtx, &b2c_app_v1.Options{
 			QueryBalanceURL: viper.GetString("B2C_QUERY_BALANCE_URL"),
 			B2CURL:          viper.GetString("B2C_URL"),
 			ReversalURL:     viper.GetString("B2C_REVERSAL_URL"),
@@ -187,30 +356,64 @@ func main() {
 			RedisDB:         redisDB,
 			Logger:          appLogger,
 			AuthAPI:         authAPI,
-			HTTPClient:      http.DefaultClient,
+			HTTPClient: &http.Client{
+				Transport: tracing.NewTransport(httputils.NewLoggingTransport(http.DefaultTransport, httpLogOptions)),
+			},
 			B2COptions: &b2c_app_v1.B2COptions{
 				ConsumerKey:                viper.GetString("B2C_CONSUMER_KEY"),
 				ConsumerSecret:             viper.GetString("B2C_CONSUMER_SECRET"),
 				AccessTokenURL:             viper.GetString("B2C_ACCESS_TOKEN_URL"),
 				QueueTimeOutURL:            viper.GetString("B2C_QUEUE_TIMEOUT_URL"),
 				ResultURL:                  b2cCallbackV1,
+				ReversalResultURL:          viper.GetString("B2C_REVERSAL_RESULT_URL"),
 				InitiatorUsername:          viper.GetString("B2C_INITIATOR_USERNAME"),
 				InitiatorEncryptedPassword: viper.GetString("B2C_INITIATOR_ENCRYPTED_PASSWORD"),
 			},
 			TransactionCharges: 0,
+			StreamOptions: &b2c_app_v1.StreamOptions{
+				Enabled:        viper.GetBool("PUBLISH_STREAM_ENABLED"),
+				MaxLen:         viper.GetInt64("PUBLISH_STREAM_MAX_LEN"),
+				ConsumerGroups: viper.GetStringSlice("PUBLISH_STREAM_CONSUMER_GROUPS"),
+			},
+			ChangeFeed:          changeFeed,
+			HourlyStatRetention: time.Duration(viper.GetInt("HOURLY_STATS_RETENTION_DAYS")) * 24 * time.Hour,
+			Health:              healthRegistry,
+			MaxWebhookBacklog:   viper.GetInt64("HEALTH_MAX_WEBHOOK_BACKLOG"),
+			Shutdown:            shutdownCoordinator,
+			Leader:              workersElector,
+			SkipMigrations:      viper.GetBool("SKIP_MIGRATIONS"),
+			Archive: &b2c_app_v1.ArchiveOptions{
+				RetentionMonths: viper.GetInt32("ARCHIVE_RETENTION_MONTHS"),
+				Target:          archiveTarget,
+				Dir:             viper.GetString("ARCHIVE_DIR"),
+				BatchSize:       viper.GetInt("ARCHIVE_BATCH_SIZE"),
+				Interval:        viper.GetDuration("ARCHIVE_INTERVAL"),
+			},
+			PIIPolicy:    piiPolicy,
+			APIKeyGroups: viper.GetStringSlice("API_KEY_GROUPS"),
 		})
 		errs.Panic(err)
 
 		b2c_v1.RegisterB2CV1Server(app.GRPCServer(), b2cV1)
 		errs.Panic(b2c_v1.RegisterB2CV1Handler(ctx, app.RuntimeMux(), app.ClientConn()))
 
+		// gRPC health service follows readiness
+		healthServer := healthRegistry.NewGRPCServer(b2c_v1.B2CV1_ServiceDesc.ServiceName)
+		healthServer.Register(app.GRPCServer())
+		go healthServer.Monitor(workerCtx, 15*time.Second)
+
 		// Options for gateways
 		opts := &Options{
-			SQLDB:    sqlDB,
-			RedisDB:  redisDB,
-			Logger:   appLogger,
-			AuthAPI:  authAPI,
-			B2CV1API: b2cV1,
+			SQLDB:        sqlDB,
+			RedisDB:      redisDB,
+			Logger:       appLogger,
+			AuthAPI:      authAPI,
+			B2CV1API:     b2cV1,
+			ChangeFeed:   changeFeed,
+			Shutdown:     shutdownCoordinator,
+			PIIPolicy:    piiPolicy,
+			Authorizer:   authorizer,
+			Authenticate: authenticate,
 		}
 
 		// MPESA B2C Push gateway
@@ -218,13 +421,65 @@ func main() {
 		errs.Panic(err)
 
 		// V1 endpoint
-		app.AddEndpointFunc("/b2c/incoming", b2cGateway.ServeHTTP)
+		app.AddEndpoint("/b2c/incoming", httputils.LoggingHandler(http.HandlerFunc(b2cGateway.ServeHTTP), httpLogOptions))
 		appLogger.Infof("B2C incoming path: %v", b2cCallbackV1)
 
+		app.AddEndpoint("/b2c/timeout", httputils.LoggingHandler(http.HandlerFunc(b2cGateway.ServeTimeout), httpLogOptions))
+		app.AddEndpoint("/b2c/reversal", httputils.LoggingHandler(http.HandlerFunc(b2cGateway.ServeReversal), httpLogOptions))
+
+		// Server-sent events for browser dashboards
+		eventsGateway, err := NewEventsGateway(ctx, opts)
+		errs.Panic(err)
+
+		app.AddEndpointFunc("/b2c/v1/events", eventsGateway.ServeHTTP)
+
+		// Downloads of exported payments
+		exportGateway, err := NewExportGateway(ctx, opts)
+		errs.Panic(err)
+
+		app.AddEndpointFunc("/b2c/v1/export", exportGateway.ServeHTTP)
+
+		// Prometheus metrics
+		app.AddEndpoint("/metrics", metrics.Handler())
+
+		// Kubernetes probes
+		app.AddEndpoint("/healthz", healthRegistry.LivenessHandler())
+		app.AddEndpoint("/readyz", healthRegistry.ReadinessHandler())
+
+		go gracefulShutdown(&gracefulShutdownOptions{
+			Coordinator:  shutdownCoordinator,
+			HealthServer: healthServer,
+			GRPCServer:   app.GRPCServer(),
+			StopWorkers:  stopWorkers,
+			DrainTimeout: viper.GetDuration("SHUTDOWN_DRAIN_TIMEOUT"),
+			Logger:       appLogger,
+			Cleanups:     []func(context.Context) error{shutdownTracing},
+		})
+
 		return nil
 	})
 }
 
+// openSQLDB opens the sql database for the configured dialect
+func openSQLDB() (*gorm.DB, error) {
+	return database.Open(&database.Options{
+		DbOptions: &conn.DbOptions{
+			Name:     viper.GetString("mysqlName"),
+			Dialect:  viper.GetString("mysqlDialect"),
+			Address:  viper.GetString("mysqlAddress"),
+			User:     viper.GetString("mysqlUser"),
+			Password: viper.GetString("mysqlPassword"),
+			Schema:   viper.GetString("mysqlSchema"),
+			ConnPool: &conn.DbPoolSettings{
+				MaxIdleConns: viper.GetUint("mysqlMaxIdleConns"),
+				MaxOpenConns: viper.GetUint("mysqlMaxOpenConns"),
+				MaxLifetime:  viper.GetDuration("mysqlMaxLifetime"),
+			},
+		},
+		SSLMode: viper.GetString("SQL_SSL_MODE"),
+	})
+}
+
 func firstVal(vals ...string) string {
 	for _, val := range vals {
 		if val != "" {
@@ -233,3 +488,30 @@ func firstVal(vals ...string) string {
 	}
 	return ""
 }
+
+var debugLogMD = strings.ToLower(httputils.DebugHeader)
+
+// debugLogHeaderMatcher forwards the debug logging header to the gRPC handlers
+func debugLogHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, httputils.DebugHeader) {
+		return debugLogMD, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// debugLogUnaryInterceptor turns on debug logging for calls of admins with the debug logging metadata.
+//
+// Debug logs hold request and response bodies so other callers cannot turn them on.
+func debugLogUnaryInterceptor(authAPI *grpcauth.API) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if vals := metadata.ValueFromIncomingContext(ctx, debugLogMD); len(vals) > 0 {
+			debug, _ := strconv.ParseBool(vals[0])
+			if payload, err := authAPI.GetPayload(ctx); debug && err == nil && authAPI.IsAdmin(payload.Group) {
+				ctx = httputils.WithDebug(ctx)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
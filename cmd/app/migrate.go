@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	b2c_app_v1 "github.com/gidyon/mpesa-b2c/internal/b2c/v1"
+)
+
+const migrateUsage = `usage: app [-config-file .env] migrate <command> [flags]
+
+commands:
+  up [-to version]    apply pending schema migrations, up to version when set
+  down [-steps n]     revert the last n applied schema migrations (default 1)
+  status              list schema migrations and whether they are applied`
+
+// runMigrate runs the migrate command against the configured database
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	fs := flag.NewFlagSet("migrate "+args[0], flag.ContinueOnError)
+	to := fs.Int64("to", 0, "Version to migrate up to; zero applies all pending migrations")
+	steps := fs.Int("steps", 1, "Number of migrations to revert")
+
+	err := fs.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+
+	sqlDB, err := openSQLDB()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := b2c_app_v1.MigrateSchema(ctx, sqlDB, *to)
+		for _, name := range applied {
+			fmt.Printf("applied %s\n", name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+	case "down":
+		reverted, err := b2c_app_v1.RollbackSchema(ctx, sqlDB, *steps)
+		for _, name := range reverted {
+			fmt.Printf("reverted %s\n", name)
+		}
+		if err != nil {
+			return err
+		}
+	case "status":
+		statuses, err := b2c_app_v1.SchemaStatus(ctx, sqlDB)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.Applied {
+				appliedAt = status.AppliedAt.UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gidyon/mpesa-b2c/internal/health"
+	"github.com/gidyon/mpesa-b2c/internal/shutdown"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/grpclog"
+)
+
+const (
+	defaultDrainTimeout = 25 * time.Second
+	stopTimeout         = 5 * time.Second
+)
+
+type gracefulShutdownOptions struct {
+	Coordinator  *shutdown.Coordinator
+	HealthServer *health.GRPCServer
+	GRPCServer   *grpc.Server
+	StopWorkers  context.CancelFunc
+	DrainTimeout time.Duration
+	Logger       grpclog.LoggerV2
+	// Cleanups run after the servers stop e.g. flushing traces
+	Cleanups []func(context.Context) error
+}
+
+// gracefulShutdown waits for SIGTERM then drains in-flight transfers and callbacks before exiting.
+//
+// Interrupts are left to gomicro which stops the servers immediately.
+func gracefulShutdown(opt *gracefulShutdownOptions) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM)
+	<-sigs
+
+	if opt.DrainTimeout <= 0 {
+		opt.DrainTimeout = defaultDrainTimeout
+	}
+
+	opt.Logger.Warningf("shutting down; draining %d in-flight transfers and callbacks", opt.Coordinator.InFlight())
+
+	// New transfers are rejected from here on; report not serving so traffic moves to other replicas
+	opt.HealthServer.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), opt.DrainTimeout)
+	unfinished := opt.Coordinator.Drain(ctx)
+	cancel()
+
+	for _, task := range unfinished {
+		opt.Logger.Errorf(
+			"shutdown: unfinished %s started %s ago: %s",
+			task.Kind, time.Since(task.StartedAt).Round(time.Millisecond), task.Description,
+		)
+	}
+	if len(unfinished) > 0 {
+		opt.Logger.Errorf("shutdown: %d in-flight tasks did not finish within %s", len(unfinished), opt.DrainTimeout)
+	} else {
+		opt.Logger.Infoln("shutdown: in-flight work drained")
+	}
+
+	opt.StopWorkers()
+
+	stopped := make(chan struct{})
+	go func() {
+		opt.GRPCServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(stopTimeout):
+		opt.GRPCServer.Stop()
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), stopTimeout)
+	for _, cleanup := range opt.Cleanups {
+		if err := cleanup(ctx); err != nil {
+			opt.Logger.Errorf("shutdown: cleanup failed: %v", err)
+		}
+	}
+	cancel()
+
+	if len(unfinished) > 0 {
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
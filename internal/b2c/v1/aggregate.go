@@ -0,0 +1,246 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultAggregateLimit = 1000
+	maxAggregateLimit     = 10000
+)
+
+// aggregateGroupColumns maps the group by options to payment columns and their result aliases
+var aggregateGroupColumns = map[b2c.AggregateGroupBy]string{
+	b2c.AggregateGroupBy_AGGREGATE_GROUP_BY_SHORT_CODE: "org_short_code",
+	b2c.AggregateGroupBy_AGGREGATE_GROUP_BY_INITIATOR:  "initiator_id",
+	b2c.AggregateGroupBy_AGGREGATE_GROUP_BY_COMMAND_ID: "command_id",
+	b2c.AggregateGroupBy_AGGREGATE_GROUP_BY_STATUS:     "b2c_status",
+	b2c.AggregateGroupBy_AGGREGATE_GROUP_BY_MSISDN:     "msisdn",
+}
+
+// timeBucketExpr returns the sql expression for the start of the time bucket a payment falls in.
+//
+// Buckets are computed on the wall clock of the reporting timezone using its current offset.
+func timeBucketExpr(dialect string, bucket b2c.TimeBucket) string {
+	_, offset := time.Now().In(timeutil.ReportingLocation()).Zone()
+
+	switch dialect {
+	case "postgres":
+		txTime := fmt.Sprintf("(transaction_time + INTERVAL '%d seconds')", offset)
+		switch bucket {
+		case b2c.TimeBucket_TIME_BUCKET_HOUR:
+			return "TO_CHAR(" + txTime + ", 'YYYY-MM-DD HH24:00:00')"
+		case b2c.TimeBucket_TIME_BUCKET_DAY:
+			return "TO_CHAR(" + txTime + ", 'YYYY-MM-DD')"
+		case b2c.TimeBucket_TIME_BUCKET_WEEK:
+			// ISO weeks start on Monday
+			return "TO_CHAR(DATE_TRUNC('week', " + txTime + "), 'YYYY-MM-DD')"
+		case b2c.TimeBucket_TIME_BUCKET_MONTH:
+			return "TO_CHAR(" + txTime + ", 'YYYY-MM-01')"
+		}
+	case "sqlite":
+		txTime := fmt.Sprintf("DATETIME(transaction_time, '%+d seconds')", offset)
+		switch bucket {
+		case b2c.TimeBucket_TIME_BUCKET_HOUR:
+			return "STRFTIME('%Y-%m-%d %H:00:00', " + txTime + ")"
+		case b2c.TimeBucket_TIME_BUCKET_DAY:
+			return "STRFTIME('%Y-%m-%d', " + txTime + ")"
+		case b2c.TimeBucket_TIME_BUCKET_WEEK:
+			// Weeks start on Monday; %w counts from Sunday
+			return "DATE(" + txTime + ", '-' || ((CAST(STRFTIME('%w', " + txTime + ") AS INTEGER) + 6) % 7) || ' days')"
+		case b2c.TimeBucket_TIME_BUCKET_MONTH:
+			return "STRFTIME('%Y-%m-01', " + txTime + ")"
+		}
+	default:
+		txTime := fmt.Sprintf("DATE_ADD(transaction_time, INTERVAL %d SECOND)", offset)
+		switch bucket {
+		case b2c.TimeBucket_TIME_BUCKET_HOUR:
+			return "DATE_FORMAT(" + txTime + ", '%Y-%m-%d %H:00:00')"
+		case b2c.TimeBucket_TIME_BUCKET_DAY:
+			return "DATE_FORMAT(" + txTime + ", '%Y-%m-%d')"
+		case b2c.TimeBucket_TIME_BUCKET_WEEK:
+			// Weeks start on Monday
+			return "DATE_FORMAT(DATE_SUB(" + txTime + ", INTERVAL WEEKDAY(" + txTime + ") DAY), '%Y-%m-%d')"
+		case b2c.TimeBucket_TIME_BUCKET_MONTH:
+			return "DATE_FORMAT(" + txTime + ", '%Y-%m-01')"
+		}
+	}
+	return ""
+}
+
+type paymentAggregate struct {
+	OrgShortCode      string
+	InitiatorID       string
+	CommandID         string
+	B2CStatus         string `gorm:"column:b2c_status"`
+	Msisdn            string
+	BucketStart       string
+	Count             int64
+	SuccessfulCount   int64
+	TotalAmount       float64
+	AverageAmount     float64
+	TotalCharges      float64
+	TotalMpesaCharges float64
+}
+
+func (b2cAPI *b2cAPIServer) AggregatePayments(
+	ctx context.Context, req *b2c.AggregatePaymentsRequest,
+) (*b2c.AggregatePaymentsResponse, error) {
+	// Authorization
+	payload, err := b2cAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dialect := b2cAPI.SQLDB.Dialector.Name()
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("aggregate request")
+	case len(req.GroupBy) == 0 && req.TimeBucket == b2c.TimeBucket_TIME_BUCKET_UNSPECIFIED:
+		return nil, errs.MissingField("group by or time bucket")
+	case req.TimeBucket != b2c.TimeBucket_TIME_BUCKET_UNSPECIFIED && timeBucketExpr(dialect, req.TimeBucket) == "":
+		return nil, errs.IncorrectVal("time bucket")
+	case req.Limit < 0 || req.Limit > maxAggregateLimit:
+		return nil, errs.IncorrectVal("limit")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultAggregateLimit
+	}
+
+	groupsMsisdn := false
+
+	groups := make([]string, 0, len(req.GroupBy)+1)
+	for _, groupBy := range req.GroupBy {
+		col, ok := aggregateGroupColumns[groupBy]
+		if !ok {
+			return nil, errs.IncorrectVal("group by")
+		}
+		if col == "msisdn" {
+			col = msisdnColumn()
+			groupsMsisdn = true
+		}
+		groups = append(groups, col)
+	}
+	if req.TimeBucket != b2c.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		groups = append(groups, timeBucketExpr(dialect, req.TimeBucket))
+	}
+
+	// Filter windows apply to the transaction time unless another order field is given
+	filter, _ := proto.Clone(req.GetFilter()).(*b2c.ListB2CPaymentFilter)
+	if filter == nil {
+		filter = &b2c.ListB2CPaymentFilter{}
+	}
+	if filter.OrderField == b2c.B2COrderField_B2C_ORDER_FIELD_UNSPECIFIED {
+		filter.OrderField = b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP
+	}
+
+	db, err := filterPayments(scopePayments(ctx, b2cAPI.SQLDB.WithContext(ctx).Model(&Payment{})), filter)
+	if err != nil {
+		return nil, err
+	}
+
+	selects := make([]string, 0, len(groups)+6)
+	for _, groupBy := range req.GroupBy {
+		col := aggregateGroupColumns[groupBy]
+		if col == "msisdn" && pii.Enabled() {
+			// Any of the encrypted msisdns of a blind index decrypts to the msisdn
+			col = "MIN(msisdn) AS msisdn"
+		}
+		selects = append(selects, col)
+	}
+	if req.TimeBucket != b2c.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		selects = append(selects, timeBucketExpr(dialect, req.TimeBucket)+" AS bucket_start")
+	}
+	selects = append(selects,
+		"COUNT(*) AS count",
+		"SUM(CASE WHEN succeeded = 'YES' THEN 1 ELSE 0 END) AS successful_count",
+		"COALESCE(SUM(transaction_amount), 0) AS total_amount",
+		"COALESCE(AVG(transaction_amount), 0) AS average_amount",
+		"COALESCE(SUM(system_charges), 0) AS total_charges",
+		"COALESCE(SUM(mpesa_charges), 0) AS total_mpesa_charges",
+	)
+
+	// Groups are ordered by time bucket so that series read naturally
+	order := "count DESC"
+	if req.TimeBucket != b2c.TimeBucket_TIME_BUCKET_UNSPECIFIED {
+		order = "bucket_start, count DESC"
+	}
+
+	aggregates := make([]*paymentAggregate, 0)
+
+	err = db.Select(strings.Join(selects, ", ")).
+		Group(strings.Join(groups, ", ")).
+		Order(order).
+		Limit(limit + 1).
+		Scan(&aggregates).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to aggregate b2c payments")
+	}
+
+	res := &b2c.AggregatePaymentsResponse{
+		Aggregates: make([]*b2c.PaymentAggregate, 0, len(aggregates)),
+	}
+
+	if len(aggregates) > limit {
+		aggregates = aggregates[:limit]
+		res.Truncated = true
+	}
+
+	access := b2cAPI.PIIPolicy.Access(payload)
+
+	for _, v := range aggregates {
+		msisdn, err := pii.Decrypt(v.Msisdn)
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to decrypt msisdn")
+		}
+		if !access.Unmasked {
+			msisdn = MaskMsisdn(msisdn)
+		}
+
+		pb := &b2c.PaymentAggregate{
+			OrgShortCode:      v.OrgShortCode,
+			InitiatorId:       v.InitiatorID,
+			CommandId:         b2c.CommandId(b2c.CommandId_value[v.CommandID]),
+			B2CStatus:         b2c.B2CStatus(b2c.B2CStatus_value[v.B2CStatus]),
+			Msisdn:            msisdn,
+			BucketStart:       v.BucketStart,
+			Count:             v.Count,
+			SuccessfulCount:   v.SuccessfulCount,
+			TotalAmount:       v.TotalAmount,
+			AverageAmount:     v.AverageAmount,
+			TotalCharges:      v.TotalCharges,
+			TotalMpesaCharges: v.TotalMpesaCharges,
+		}
+		if v.Count > 0 {
+			pb.SuccessRate = float64(v.SuccessfulCount) / float64(v.Count)
+		}
+		res.Aggregates = append(res.Aggregates, pb)
+	}
+
+	// Aggregates have no payment ids so the number of msisdns seen is recorded
+	if groupsMsisdn {
+		err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "AggregatePayments", fmt.Sprintf("group_by=msisdn msisdns=%d", len(res.Aggregates)))
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to aggregate b2c payments")
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,383 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	apiKeysTable = "b2c_api_keys"
+	// APIKeyPrefix starts every API key so that keys can be told apart from JWTs
+	APIKeyPrefix = "b2ck_"
+	// APIKeyGroup is the auth group of keys created without one
+	APIKeyGroup = "API_KEY"
+	// last used times are written at most once in this interval per key
+	apiKeyLastUsedInterval = time.Minute
+)
+
+// APIKey is a hashed key used by service callers instead of a JWT
+type APIKey struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	KeyID       string `gorm:"uniqueIndex;type:varchar(32);not null"`
+	SecretHash  string `gorm:"type:varchar(64);not null"`
+	Name        string `gorm:"type:varchar(100);not null"`
+	Group       string `gorm:"column:key_group;type:varchar(50);not null"`
+	RPCs        string `gorm:"column:rpcs;type:text;not null"`
+	ShortCodes  string `gorm:"type:text"`
+	InitiatorID string `gorm:"index;type:varchar(50)"`
+	CreatedBy   string `gorm:"type:varchar(50)"`
+	ExpiresAt   sql.NullTime
+	LastUsedAt  sql.NullTime
+	RevokedAt   sql.NullTime `gorm:"index"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime"`
+}
+
+// TableName is table name for model
+func (*APIKey) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), apiKeysTable)
+	}
+	return apiKeysTable
+}
+
+// Payload is the auth payload of callers using the key
+func (db *APIKey) Payload() *auth.Payload {
+	return &auth.Payload{
+		ID:    "apikey:" + db.KeyID,
+		Names: db.Name,
+		Group: db.Group,
+	}
+}
+
+// Rule is the rule limiting callers using the key
+func (db *APIKey) Rule() *rbac.Rule {
+	rule := &rbac.Rule{
+		RPCs:       splitList(db.RPCs),
+		ShortCodes: splitList(db.ShortCodes),
+	}
+	if db.InitiatorID != "" {
+		rule.Initiators = []string{db.InitiatorID}
+	}
+	return rule
+}
+
+// APIKeyProto gets API key protobuf from model; the secret is never included
+func APIKeyProto(db *APIKey) *b2c.APIKey {
+	pb := &b2c.APIKey{
+		KeyId:             db.KeyID,
+		Name:              db.Name,
+		Group:             db.Group,
+		Rpcs:              splitList(db.RPCs),
+		ShortCodes:        splitList(db.ShortCodes),
+		InitiatorId:       db.InitiatorID,
+		CreatedBy:         db.CreatedBy,
+		Revoked:           db.RevokedAt.Valid,
+		CreateTimeSeconds: db.CreatedAt.Unix(),
+	}
+	if db.ExpiresAt.Valid {
+		pb.ExpireTimeSeconds = db.ExpiresAt.Time.Unix()
+	}
+	if db.LastUsedAt.Valid {
+		pb.LastUsedTimeSeconds = db.LastUsedAt.Time.Unix()
+	}
+	if db.RevokedAt.Valid {
+		pb.RevokeTimeSeconds = db.RevokedAt.Time.Unix()
+	}
+	return pb
+}
+
+func splitList(v string) []string {
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsAPIKey reports whether the bearer token is an API key rather than a JWT
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// AuthenticateAPIKey returns the key of a bearer token that is an API key.
+//
+// Keys that do not exist, are revoked or have expired are Unauthenticated.
+func AuthenticateAPIKey(ctx context.Context, sqlDB *gorm.DB, key string) (*APIKey, error) {
+	keyID, secret, ok := strings.Cut(strings.TrimPrefix(key, APIKeyPrefix), "_")
+	if !IsAPIKey(key) || !ok || keyID == "" || secret == "" {
+		return nil, status.Error(codes.Unauthenticated, "malformed api key")
+	}
+
+	db := &APIKey{}
+
+	err := sqlDB.WithContext(ctx).First(db, "key_id = ?", keyID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	default:
+		return nil, fmt.Errorf("failed to get api key: %v", err)
+	}
+
+	now := time.Now().UTC()
+
+	switch {
+	case subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(secret)), []byte(db.SecretHash)) != 1:
+		return nil, status.Error(codes.Unauthenticated, "invalid api key")
+	case db.RevokedAt.Valid:
+		return nil, status.Error(codes.Unauthenticated, "api key has been revoked")
+	case db.ExpiresAt.Valid && !now.Before(db.ExpiresAt.Time):
+		return nil, status.Error(codes.Unauthenticated, "api key has expired")
+	}
+
+	return db, nil
+}
+
+// TouchAPIKey records that the key was used.
+//
+// Last used times are approximate to spare a write on every call. Callers should not fail authentication on errors.
+func TouchAPIKey(ctx context.Context, sqlDB *gorm.DB, db *APIKey) error {
+	now := time.Now().UTC()
+	if db.LastUsedAt.Valid && now.Sub(db.LastUsedAt.Time) < apiKeyLastUsedInterval {
+		return nil
+	}
+
+	err := sqlDB.WithContext(ctx).Model(&APIKey{}).Where("id = ?", db.ID).Update("last_used_at", now).Error
+	if err != nil {
+		return fmt.Errorf("failed to update api key last use: %v", err)
+	}
+	db.LastUsedAt = sql.NullTime{Time: now, Valid: true}
+
+	return nil
+}
+
+// APIKeyGroupAllowed reports whether API keys may be created in the group.
+//
+// Keys never carry admin groups as admins bypass authorization; other groups must be configured.
+func APIKeyGroupAllowed(authAPI *auth.API, allowed []string, group string) bool {
+	switch {
+	case authAPI.IsAdmin(group):
+		return false
+	case group == "" || group == APIKeyGroup:
+		return true
+	}
+	for _, v := range allowed {
+		if v == group {
+			return true
+		}
+	}
+	return false
+}
+
+func (b2cAPI *b2cAPIServer) apiKeyGroupAllowed(group string) bool {
+	return APIKeyGroupAllowed(b2cAPI.AuthAPI, b2cAPI.APIKeyGroups, group)
+}
+
+func (b2cAPI *b2cAPIServer) CreateAPIKey(
+	ctx context.Context, req *b2c.CreateAPIKeyRequest,
+) (*b2c.CreateAPIKeyResponse, error) {
+	// Authorization
+	payload, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("create request")
+	case req.ApiKey == nil:
+		return nil, errs.MissingField("api key")
+	case req.ApiKey.Name == "":
+		return nil, errs.MissingField("name")
+	case len(req.ApiKey.Rpcs) == 0:
+		return nil, errs.MissingField("rpcs")
+	case req.ApiKey.ExpireTimeSeconds != 0 && req.ApiKey.ExpireTimeSeconds <= time.Now().Unix():
+		return nil, errs.IncorrectVal("expire time")
+	case strings.Contains(req.ApiKey.InitiatorId, ","):
+		return nil, errs.IncorrectVal("initiator id")
+	case !b2cAPI.apiKeyGroupAllowed(req.ApiKey.Group):
+		return nil, errs.WrapMessage(codes.InvalidArgument, fmt.Sprintf("api keys are not allowed in group %s", req.ApiKey.Group))
+	default:
+		err = (&rbac.Rule{RPCs: req.ApiKey.Rpcs}).Validate(&b2c.B2CV1_ServiceDesc)
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect rpcs")
+		}
+	}
+
+	// Short codes are stored comma separated
+	for _, shortCode := range req.ApiKey.ShortCodes {
+		if shortCode == "" || strings.Contains(shortCode, ",") {
+			return nil, errs.IncorrectVal("short codes")
+		}
+	}
+
+	keyID, err := randomHex(8)
+	if err != nil {
+		return nil, errs.WrapMessage(codes.Internal, "failed to generate api key")
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return nil, errs.WrapMessage(codes.Internal, "failed to generate api key")
+	}
+
+	group := req.ApiKey.Group
+	if group == "" {
+		group = APIKeyGroup
+	}
+
+	db := &APIKey{
+		KeyID:       keyID,
+		SecretHash:  hashAPIKeySecret(secret),
+		Name:        firstN(req.ApiKey.Name, 100),
+		Group:       group,
+		RPCs:        strings.Join(req.ApiKey.Rpcs, ","),
+		ShortCodes:  strings.Join(req.ApiKey.ShortCodes, ","),
+		InitiatorID: req.ApiKey.InitiatorId,
+		CreatedBy:   firstN(payload.ID, 50),
+	}
+	if req.ApiKey.ExpireTimeSeconds != 0 {
+		db.ExpiresAt = sql.NullTime{Time: time.Unix(req.ApiKey.ExpireTimeSeconds, 0).UTC(), Valid: true}
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Create(db).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to create api key")
+	}
+
+	// Key is only revealed when it is created
+	return &b2c.CreateAPIKeyResponse{
+		ApiKey: APIKeyProto(db),
+		Key:    APIKeyPrefix + keyID + "_" + secret,
+	}, nil
+}
+
+func (b2cAPI *b2cAPIServer) ListAPIKeys(
+	ctx context.Context, req *b2c.ListAPIKeysRequest,
+) (*b2c.ListAPIKeysResponse, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	ID, err := parsePageTokenID(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	// Apply filters
+	if len(req.InitiatorIds) > 0 {
+		db = db.Where("initiator_id IN(?)", req.InitiatorIds)
+	}
+	if !req.IncludeRevoked {
+		db = db.Where("revoked_at IS NULL")
+	}
+
+	keys := make([]*APIKey, 0, pageSize+1)
+
+	err = db.Find(&keys).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get api keys")
+	}
+
+	pbs := make([]*b2c.APIKey, 0, len(keys))
+
+	for i, key := range keys {
+		if i == int(pageSize) {
+			break
+		}
+		pbs = append(pbs, APIKeyProto(key))
+		ID = key.ID
+	}
+
+	var token string
+	if len(keys) > int(pageSize) {
+		// Next page token
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(ID)))
+	}
+
+	return &b2c.ListAPIKeysResponse{
+		NextPageToken: token,
+		ApiKeys:       pbs,
+	}, nil
+}
+
+func (b2cAPI *b2cAPIServer) RevokeAPIKey(
+	ctx context.Context, req *b2c.RevokeAPIKeyRequest,
+) (*b2c.APIKey, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("revoke request")
+	case req.KeyId == "":
+		return nil, errs.MissingField("key id")
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Model(&APIKey{}).
+		Where("key_id = ? AND revoked_at IS NULL", req.KeyId).
+		Update("revoked_at", time.Now().UTC()).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to revoke api key")
+	}
+
+	db := &APIKey{}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).First(db, "key_id = ?", req.KeyId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("api key", req.KeyId)
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get api key")
+	}
+
+	return APIKeyProto(db), nil
+}
@@ -0,0 +1,92 @@
+//go:build cgo
+
+package b2c_app_v1
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	sqlDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "b2c.db")), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sqlDB.AutoMigrate(&APIKey{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().UTC()
+
+	keys := []*APIKey{
+		{KeyID: "active", ExpiresAt: sql.NullTime{Time: now.Add(time.Hour), Valid: true}},
+		{KeyID: "revoked", RevokedAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true}},
+		{KeyID: "expired", ExpiresAt: sql.NullTime{Time: now.Add(-time.Minute), Valid: true}},
+	}
+	for _, key := range keys {
+		key.SecretHash = hashAPIKeySecret("secret")
+		key.Name = key.KeyID
+		key.Group = APIKeyGroup
+		key.RPCs = "GetB2CPayment"
+		err = sqlDB.Create(key).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		code codes.Code
+	}{
+		{"valid", APIKeyPrefix + "active_secret", codes.OK},
+		{"bad secret", APIKeyPrefix + "active_secreT", codes.Unauthenticated},
+		{"revoked", APIKeyPrefix + "revoked_secret", codes.Unauthenticated},
+		{"expired", APIKeyPrefix + "expired_secret", codes.Unauthenticated},
+		{"unknown key", APIKeyPrefix + "missing_secret", codes.Unauthenticated},
+		{"missing secret", APIKeyPrefix + "active_", codes.Unauthenticated},
+		{"missing prefix", "active_secret", codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := AuthenticateAPIKey(context.Background(), sqlDB, tt.key)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("expected %s, got %v", tt.code, err)
+			}
+			if tt.code == codes.OK && key.KeyID != "active" {
+				t.Errorf("authenticated key %s", key.KeyID)
+			}
+		})
+	}
+
+	t.Run("last use", func(t *testing.T) {
+		key, err := AuthenticateAPIKey(context.Background(), sqlDB, APIKeyPrefix+"active_secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = TouchAPIKey(context.Background(), sqlDB, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got := &APIKey{}
+		err = sqlDB.First(got, "key_id = ?", "active").Error
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.LastUsedAt.Valid {
+			t.Error("last used time was not saved")
+		}
+	})
+}
@@ -0,0 +1,611 @@
+package b2c_app_v1
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	archivedPaymentsTable     = "b2c_transactions_archive"
+	archivedPaymentFilesTable = "b2c_archived_payment_files"
+	archivalRunsTable         = "b2c_archival_runs"
+
+	// archiveLockKey is held while a run moves payments; it expires if the replica running it stops
+	archiveLockKey = "archivelock:payments"
+	archiveLockTTL = 5 * time.Minute
+
+	defaultArchiveBatchSize = 500
+	defaultArchiveInterval  = 24 * time.Hour
+
+	// running archival runs not updated within this duration are reported as failed
+	archivalRunStaleAfter = 15 * time.Minute
+
+	archivalRequestedByScheduler = "scheduler"
+)
+
+var errArchivalInProgress = errors.New("an archival run is already in progress")
+
+// ArchiveOptions contains options for archiving old payments
+type ArchiveOptions struct {
+	// RetentionMonths is how many months payments stay in the payments table; zero disables scheduled archival
+	RetentionMonths int32
+	// Target is where archived payments are moved to; defaults to the archive table
+	Target b2c.ArchiveTarget
+	// Dir is the directory of archive files. Payments archived to files can only be read by replicas that share it
+	Dir string
+	// BatchSize is the number of payments moved in one transaction
+	BatchSize int
+	// Interval is the time between scheduled runs; defaults to a day
+	Interval time.Duration
+}
+
+// ParseArchiveTarget parses a configured archive target; an empty target is the archive table
+func ParseArchiveTarget(v string) (b2c.ArchiveTarget, error) {
+	switch v {
+	case "", "table":
+		return b2c.ArchiveTarget_ARCHIVE_TABLE, nil
+	case "files":
+		return b2c.ArchiveTarget_ARCHIVE_FILES, nil
+	}
+	return b2c.ArchiveTarget_ARCHIVE_TARGET_UNSPECIFIED, fmt.Errorf("unknown archive target %q; use table or files", v)
+}
+
+func validateArchiveOptions(opt *ArchiveOptions) error {
+	if opt.Target == b2c.ArchiveTarget_ARCHIVE_TARGET_UNSPECIFIED {
+		opt.Target = b2c.ArchiveTarget_ARCHIVE_TABLE
+	}
+	if opt.BatchSize <= 0 {
+		opt.BatchSize = defaultArchiveBatchSize
+	}
+	if opt.Interval <= 0 {
+		opt.Interval = defaultArchiveInterval
+	}
+
+	switch {
+	case opt.RetentionMonths < 0:
+		return errs.IncorrectVal("archive retention months")
+	case opt.Target == b2c.ArchiveTarget_ARCHIVE_FILES && opt.Dir == "":
+		return errs.MissingField("archive dir")
+	}
+
+	return nil
+}
+
+// ArchivedPayment is a payment moved out of the payments table
+type ArchivedPayment struct {
+	Payment
+	RunID      uint      `gorm:"index"`
+	ArchivedAt time.Time `gorm:"precision:6"`
+}
+
+// TableName is table name for model
+func (*ArchivedPayment) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), archivedPaymentsTable)
+	}
+	return archivedPaymentsTable
+}
+
+// ArchivedPaymentFile is the archive file a payment was written to
+type ArchivedPaymentFile struct {
+	PaymentID      uint           `gorm:"primaryKey;autoIncrement:false"`
+	MpesaReceiptId sql.NullString `gorm:"index;type:varchar(50)"`
+	File           string         `gorm:"type:varchar(255);not null"`
+	RunID          uint           `gorm:"index"`
+	ArchivedAt     time.Time      `gorm:"precision:6"`
+}
+
+// TableName is table name for model
+func (*ArchivedPaymentFile) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), archivedPaymentFilesTable)
+	}
+	return archivedPaymentFilesTable
+}
+
+// ArchivalRun is a run moving payments older than the retention out of the payments table
+type ArchivalRun struct {
+	ID               uint   `gorm:"primaryKey;autoIncrement"`
+	Status           string `gorm:"index;type:varchar(30)"`
+	Target           string `gorm:"type:varchar(30)"`
+	RetentionMonths  int32
+	CutoffTime       time.Time `gorm:"precision:6;not null"`
+	PaymentsArchived int64
+	File             string       `gorm:"type:varchar(255)"`
+	Error            string       `gorm:"type:varchar(300)"`
+	RequestedBy      string       `gorm:"type:varchar(50)"`
+	StartedAt        time.Time    `gorm:"precision:6;not null"`
+	EndedAt          sql.NullTime `gorm:"precision:6"`
+	UpdatedAt        time.Time    `gorm:"autoUpdateTime;precision:6"`
+}
+
+// TableName is table name for model
+func (*ArchivalRun) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), archivalRunsTable)
+	}
+	return archivalRunsTable
+}
+
+// ArchivalRunProto gets archival run protobuf from model
+func ArchivalRunProto(db *ArchivalRun) *b2c.ArchivalRun {
+	pb := &b2c.ArchivalRun{
+		RunId:             fmt.Sprint(db.ID),
+		Status:            b2c.ArchivalRunStatus(b2c.ArchivalRunStatus_value[db.Status]),
+		Target:            b2c.ArchiveTarget(b2c.ArchiveTarget_value[db.Target]),
+		RetentionMonths:   db.RetentionMonths,
+		CutoffTimeSeconds: db.CutoffTime.Unix(),
+		PaymentsArchived:  db.PaymentsArchived,
+		File:              db.File,
+		Error:             db.Error,
+		RequestedBy:       db.RequestedBy,
+		StartTimeSeconds:  db.StartedAt.Unix(),
+		UpdateTimeSeconds: db.UpdatedAt.Unix(),
+	}
+	if db.EndedAt.Valid {
+		pb.EndTimeSeconds = db.EndedAt.Time.Unix()
+	}
+	return pb
+}
+
+func (b2cAPI *b2cAPIServer) ArchivePayments(
+	ctx context.Context, req *b2c.ArchivePaymentsRequest,
+) (*b2c.ArchivalRun, error) {
+	// Authorization
+	payload, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("archive request")
+	case req.RetentionMonths < 0:
+		return nil, errs.IncorrectVal("retention months")
+	}
+
+	retentionMonths := req.RetentionMonths
+	if retentionMonths == 0 {
+		retentionMonths = b2cAPI.Archive.RetentionMonths
+	}
+	if retentionMonths == 0 {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "retention months is required as archive retention is not configured")
+	}
+
+	runDB, err := b2cAPI.startArchivalRun(ctx, retentionMonths, payload.ID)
+	switch {
+	case err == nil:
+	case errors.Is(err, errArchivalInProgress):
+		return nil, errs.WrapMessage(codes.FailedPrecondition, err.Error())
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to start archival run")
+	}
+
+	// The run outlives the request
+	go b2cAPI.runArchival(b2cAPI.ctx, runDB)
+
+	return ArchivalRunProto(runDB), nil
+}
+
+func (b2cAPI *b2cAPIServer) GetArchivalRun(
+	ctx context.Context, req *b2c.GetArchivalRunRequest,
+) (*b2c.ArchivalRun, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("get request")
+	case req.RunId == "":
+		return nil, errs.MissingField("run id")
+	}
+
+	runDB := &ArchivalRun{}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).First(runDB, "id = ?", req.RunId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("archival run", req.RunId)
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get archival run")
+	}
+
+	b2cAPI.expireArchivalRun(ctx, runDB)
+
+	return ArchivalRunProto(runDB), nil
+}
+
+func (b2cAPI *b2cAPIServer) ListArchivalRuns(
+	ctx context.Context, req *b2c.ListArchivalRunsRequest,
+) (*b2c.ListArchivalRunsResponse, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	ID, err := parsePageTokenID(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	// Apply filters
+	if len(req.Statuses) > 0 {
+		ss := make([]string, 0, len(req.Statuses))
+		for _, s := range req.Statuses {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("status IN(?)", ss)
+	}
+
+	runs := make([]*ArchivalRun, 0, pageSize+1)
+
+	err = db.Find(&runs).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get archival runs")
+	}
+
+	pbs := make([]*b2c.ArchivalRun, 0, len(runs))
+
+	for i, runDB := range runs {
+		if i == int(pageSize) {
+			break
+		}
+		b2cAPI.expireArchivalRun(ctx, runDB)
+		pbs = append(pbs, ArchivalRunProto(runDB))
+		ID = runDB.ID
+	}
+
+	var token string
+	if len(runs) > int(pageSize) {
+		// Next page token
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(ID)))
+	}
+
+	return &b2c.ListArchivalRunsResponse{
+		NextPageToken: token,
+		Runs:          pbs,
+	}, nil
+}
+
+// expireArchivalRun marks a running run that stopped making progress as failed
+func (b2cAPI *b2cAPIServer) expireArchivalRun(ctx context.Context, runDB *ArchivalRun) {
+	if runDB.Status == b2c.ArchivalRunStatus_ARCHIVAL_RUN_RUNNING.String() && time.Since(runDB.UpdatedAt) > archivalRunStaleAfter {
+		b2cAPI.failArchivalRun(ctx, runDB, errors.New("run stopped making progress"))
+		runDB.Status = b2c.ArchivalRunStatus_ARCHIVAL_RUN_FAILED.String()
+	}
+}
+
+func (b2cAPI *b2cAPIServer) archivalWorker(ctx context.Context, dur time.Duration) {
+	ticker := time.NewTicker(dur)
+	defer ticker.Stop()
+
+	b2cAPI.heartbeat(workerArchival, dur)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b2cAPI.heartbeat(workerArchival, dur)
+
+			runCtx, ok := b2cAPI.leaderRun(ctx, workerArchival)
+			if !ok {
+				continue
+			}
+
+			runDB, err := b2cAPI.startArchivalRun(runCtx, b2cAPI.Archive.RetentionMonths, archivalRequestedByScheduler)
+			switch {
+			case err == nil:
+				b2cAPI.runArchival(runCtx, runDB)
+			case errors.Is(err, errArchivalInProgress):
+				b2cAPI.Logger.Infoln("ARCHIVAL: skipping scheduled run; a run is already in progress")
+			default:
+				b2cAPI.Logger.Errorf("ARCHIVAL: failed to start scheduled run: %v", err)
+			}
+		}
+	}
+}
+
+// startArchivalRun takes the archive lock and records a new run; the lock is released when the run ends
+func (b2cAPI *b2cAPIServer) startArchivalRun(ctx context.Context, retentionMonths int32, requestedBy string) (*ArchivalRun, error) {
+	ok, err := b2cAPI.RedisDB.SetNX(ctx, archiveLockKey, requestedBy, archiveLockTTL).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to take archive lock: %v", err)
+	}
+	if !ok {
+		return nil, errArchivalInProgress
+	}
+
+	now := time.Now().UTC()
+
+	runDB := &ArchivalRun{
+		Status:          b2c.ArchivalRunStatus_ARCHIVAL_RUN_RUNNING.String(),
+		Target:          b2cAPI.Archive.Target.String(),
+		RetentionMonths: retentionMonths,
+		CutoffTime:      now.AddDate(0, -int(retentionMonths), 0),
+		RequestedBy:     requestedBy,
+		StartedAt:       now,
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Create(runDB).Error
+	if err == nil && b2cAPI.Archive.Target == b2c.ArchiveTarget_ARCHIVE_FILES {
+		runDB.File = filepath.Join(b2cAPI.Archive.Dir, fmt.Sprintf("payments-%s-run%d.ndjson.gz", runDB.CutoffTime.Format("20060102"), runDB.ID))
+		err = b2cAPI.SQLDB.WithContext(ctx).Model(runDB).Update("file", runDB.File).Error
+	}
+	if err != nil {
+		b2cAPI.RedisDB.Del(context.Background(), archiveLockKey)
+		return nil, fmt.Errorf("failed to create archival run: %v", err)
+	}
+
+	return runDB, nil
+}
+
+func (b2cAPI *b2cAPIServer) updateArchivalRun(ctx context.Context, runDB *ArchivalRun, updates map[string]interface{}) {
+	err := b2cAPI.SQLDB.WithContext(ctx).Model(runDB).Updates(updates).Error
+	if err != nil {
+		b2cAPI.Logger.Errorf("ARCHIVAL: failed to update run [%d]: %v", runDB.ID, err)
+	}
+}
+
+func (b2cAPI *b2cAPIServer) failArchivalRun(ctx context.Context, runDB *ArchivalRun, err error) {
+	b2cAPI.Logger.Errorf("ARCHIVAL: run [%d] failed: %v", runDB.ID, err)
+
+	// The run is marked failed even when it was cancelled
+	b2cAPI.updateArchivalRun(context.Background(), runDB, map[string]interface{}{
+		"status":   b2c.ArchivalRunStatus_ARCHIVAL_RUN_FAILED.String(),
+		"error":    firstN(err.Error(), 300),
+		"ended_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// runArchival moves payments created before the run cutoff in batches, releasing the archive lock when done.
+//
+// Each batch is copied to the archive and deleted from the payments table in one transaction so a failed run
+// can be started again without losing or duplicating payments.
+func (b2cAPI *b2cAPIServer) runArchival(ctx context.Context, runDB *ArchivalRun) {
+	defer b2cAPI.RedisDB.Del(context.Background(), archiveLockKey)
+
+	if runDB.File != "" {
+		err := os.MkdirAll(filepath.Dir(runDB.File), 0o750)
+		if err != nil {
+			b2cAPI.failArchivalRun(ctx, runDB, err)
+			return
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			b2cAPI.failArchivalRun(ctx, runDB, ctx.Err())
+			return
+		}
+
+		// Keep the lock for as long as batches are being moved
+		err := b2cAPI.RedisDB.Expire(ctx, archiveLockKey, archiveLockTTL).Err()
+		if err != nil {
+			b2cAPI.failArchivalRun(ctx, runDB, fmt.Errorf("failed to extend archive lock: %v", err))
+			return
+		}
+
+		n, err := b2cAPI.archivePaymentsBatch(ctx, runDB)
+		if err != nil {
+			b2cAPI.failArchivalRun(ctx, runDB, err)
+			return
+		}
+		if n == 0 {
+			break
+		}
+
+		runDB.PaymentsArchived += int64(n)
+
+		b2cAPI.updateArchivalRun(ctx, runDB, map[string]interface{}{
+			"payments_archived": runDB.PaymentsArchived,
+		})
+	}
+
+	b2cAPI.updateArchivalRun(ctx, runDB, map[string]interface{}{
+		"status":   b2c.ArchivalRunStatus_ARCHIVAL_RUN_SUCCEEDED.String(),
+		"ended_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+
+	b2cAPI.Logger.Infof("ARCHIVAL: run [%d] archived %d payments created before %s", runDB.ID, runDB.PaymentsArchived, runDB.CutoffTime.Format(time.RFC3339))
+}
+
+// archivePaymentsBatch moves the next batch of payments to the archive and returns how many were moved
+func (b2cAPI *b2cAPIServer) archivePaymentsBatch(ctx context.Context, runDB *ArchivalRun) (int, error) {
+	db := b2cAPI.SQLDB.WithContext(ctx)
+
+	payments := make([]*Payment, 0, b2cAPI.Archive.BatchSize)
+
+	err := db.Where("created_at < ?", runDB.CutoffTime).Order("id").Limit(b2cAPI.Archive.BatchSize).Find(&payments).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get payments to archive: %v", err)
+	}
+	if len(payments) == 0 {
+		return 0, nil
+	}
+
+	// Payments are on disk before they are deleted; a batch written again after a failure is found through its latest file
+	if runDB.File != "" {
+		err = appendArchiveFile(runDB.File, payments)
+		if err != nil {
+			return 0, fmt.Errorf("failed to write archive file: %v", err)
+		}
+	}
+
+	now := time.Now().UTC()
+	ids := make([]uint, 0, len(payments))
+
+	err = b2cAPI.fencedTransaction(ctx, func(tx *gorm.DB) error {
+		if runDB.File != "" {
+			files := make([]*ArchivedPaymentFile, 0, len(payments))
+			for _, paymentDB := range payments {
+				files = append(files, &ArchivedPaymentFile{
+					PaymentID:      paymentDB.ID,
+					MpesaReceiptId: paymentDB.MpesaReceiptId,
+					File:           runDB.File,
+					RunID:          runDB.ID,
+					ArchivedAt:     now,
+				})
+				ids = append(ids, paymentDB.ID)
+			}
+			err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&files).Error
+			if err != nil {
+				return err
+			}
+		} else {
+			archived := make([]*ArchivedPayment, 0, len(payments))
+			for _, paymentDB := range payments {
+				archived = append(archived, &ArchivedPayment{Payment: *paymentDB, RunID: runDB.ID, ArchivedAt: now})
+				ids = append(ids, paymentDB.ID)
+			}
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&archived).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Delete(&Payment{}, "id IN(?)", ids).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to move payments to archive: %v", err)
+	}
+
+	return len(payments), nil
+}
+
+// appendArchiveFile appends payments to the archive file as a gzip member of NDJSON lines; personal data is encrypted when a keyring is set
+func appendArchiveFile(path string, payments []*Payment) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	zw := gzip.NewWriter(f)
+	enc := json.NewEncoder(zw)
+
+	for _, paymentDB := range payments {
+		encrypted, err := paymentDB.encryptPII()
+		if err != nil {
+			return err
+		}
+		err = enc.Encode(encrypted)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = zw.Close()
+	if err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+// getArchivedPayment finds a payment in the archive table or the archive files
+func (b2cAPI *b2cAPIServer) getArchivedPayment(ctx context.Context, paymentID string, isMpesaID bool) (*Payment, error) {
+	where := "id = ?"
+	if isMpesaID {
+		where = "mpesa_receipt_id = ?"
+	}
+
+	archivedDB := &ArchivedPayment{}
+
+	err := b2cAPI.SQLDB.WithContext(ctx).First(archivedDB, where, paymentID).Error
+	switch {
+	case err == nil:
+		return &archivedDB.Payment, nil
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+
+	if !isMpesaID {
+		where = "payment_id = ?"
+	}
+
+	fileDB := &ArchivedPaymentFile{}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).First(fileDB, where, paymentID).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return readArchiveFile(fileDB.File, fileDB.PaymentID)
+}
+
+// readArchiveFile reads a payment from an archive file
+func readArchiveFile(path string, paymentID uint) (*Payment, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	scanner := bufio.NewScanner(zr)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		paymentDB := &Payment{}
+		err = json.Unmarshal(scanner.Bytes(), paymentDB)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode archive file %s: %v", path, err)
+		}
+		if paymentDB.ID == paymentID {
+			return paymentDB, paymentDB.decryptPII()
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("payment %d is missing from archive file %s", paymentID, path)
+}
@@ -0,0 +1,74 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+// scopePayments limits the payments query to the grants of the caller; a payment must match one grant entirely
+func scopePayments(ctx context.Context, db *gorm.DB) *gorm.DB {
+	scope := rbac.FromContext(ctx)
+	if scope.Unrestricted() {
+		return db
+	}
+
+	conds := make([]string, 0, len(scope.Grants))
+	args := make([]interface{}, 0, 2*len(scope.Grants))
+	for _, grant := range scope.Grants {
+		parts := make([]string, 0, 2)
+		if grant.ShortCodes != nil {
+			parts = append(parts, "org_short_code IN(?)")
+			args = append(args, grant.ShortCodes)
+		}
+		if grant.Initiators != nil {
+			parts = append(parts, "initiator_id IN(?)")
+			args = append(args, grant.Initiators)
+		}
+		conds = append(conds, "("+strings.Join(parts, " AND ")+")")
+	}
+	if len(conds) == 0 {
+		return db.Where("1 = 0")
+	}
+
+	return db.Where("("+strings.Join(conds, " OR ")+")", args...)
+}
+
+// scopeStats limits the stats query to the short codes the caller may see for every initiator.
+//
+// Stats are totals of all initiators of a short code so grants limited to some initiators do not count.
+func scopeStats(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	scope := rbac.FromContext(ctx)
+	if scope.Unrestricted() {
+		return db, nil
+	}
+	shortCodes := scope.FullShortCodes()
+	if len(shortCodes) == 0 {
+		return nil, errs.WrapMessage(codes.PermissionDenied, "stats include payments of other initiators")
+	}
+	return db.Where("org_short_code IN(?)", shortCodes), nil
+}
+
+// authorizeShortCode checks that the caller may act for the short code and initiator
+func authorizeShortCode(ctx context.Context, shortCode, initiatorID string) error {
+	if !rbac.FromContext(ctx).Allows(shortCode, initiatorID) {
+		return errs.WrapMessage(
+			codes.PermissionDenied,
+			fmt.Sprintf("not allowed to act for short code %s and initiator %s", shortCode, initiatorID),
+		)
+	}
+	return nil
+}
+
+// authorizePayment checks that the payment is within the scope of the caller; payments outside it do not exist to the caller
+func authorizePayment(ctx context.Context, db *Payment, paymentID string) error {
+	if !rbac.FromContext(ctx).Allows(db.OrgShortCode, db.InitiatorID) {
+		return errs.DoesNotExist("b2c transaction", paymentID)
+	}
+	return nil
+}
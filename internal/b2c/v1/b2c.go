@@ -9,18 +9,31 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
 	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/health"
+	"github.com/gidyon/mpesa-b2c/internal/leader"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	"github.com/gidyon/mpesa-b2c/internal/shutdown"
+	"github.com/gidyon/mpesa-b2c/internal/tracing"
 	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
 	"github.com/gidyon/mpesa-b2c/pkg/utils/formatutil"
 	"github.com/gidyon/mpesa-b2c/pkg/utils/httputils"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
 	"github.com/gidyon/mpesapayments/pkg/payload"
 	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/protobuf/proto"
@@ -31,6 +44,9 @@ import (
 
 const SourceKey = "source"
 
+// how long mpesa has to respond to a transfer request
+const transferRequestTimeout = 15 * time.Second
+
 type httpClient interface {
 	Do(*http.Request) (*http.Response, error)
 }
@@ -38,6 +54,10 @@ type httpClient interface {
 type b2cAPIServer struct {
 	b2c.UnimplementedB2CV1Server
 	*Options
+	ctx          context.Context
+	streamGroups sync.Map
+	tokenState   *accessTokenState
+	heartbeats   sync.Map
 }
 
 // Options contains options for starting b2c service
@@ -53,6 +73,28 @@ type Options struct {
 	HTTPClient         httpClient
 	B2COptions         *B2COptions
 	TransactionCharges float32
+	WebhookHTTPClient  httpClient
+	WebhookMaxAttempts int32
+	StreamOptions      *StreamOptions
+	ChangeFeed         *ChangeFeed
+	// HourlyStatRetention is how long hourly statistics are kept; defaults to DefaultHourlyStatRetention
+	HourlyStatRetention time.Duration
+	// Health receives the service health checks when set
+	Health *health.Registry
+	// MaxWebhookBacklog is the number of overdue webhook deliveries that fails readiness; zero only reports the backlog
+	MaxWebhookBacklog int64
+	// Shutdown tracks in-flight transfer submissions so they can be drained on shutdown
+	Shutdown *shutdown.Coordinator
+	// Leader decides which replica runs the periodic workers; a b2c-workers election is run when nil
+	Leader *leader.Elector
+	// SkipMigrations leaves pending schema migrations to the migrate command
+	SkipMigrations bool
+	// Archive contains options for archiving old payments; scheduled archival is off when nil
+	Archive *ArchiveOptions
+	// PIIPolicy decides which callers see msisdns and names unmasked; only admin groups do when nil
+	PIIPolicy *PIIPolicy
+	// APIKeyGroups are the groups API keys may be created in besides APIKeyGroup; admin groups are never allowed
+	APIKeyGroups []string
 }
 
 // ValidateOptions validates options required by stk service
@@ -91,6 +133,7 @@ type B2COptions struct {
 	AccessTokenURL             string
 	QueueTimeOutURL            string
 	ResultURL                  string
+	ReversalResultURL          string
 	InitiatorUsername          string
 	InitiatorEncryptedPassword string
 	accessToken                string
@@ -117,6 +160,8 @@ func ValidateB2COptions(opt *B2COptions) error {
 		err = errs.MissingField("queue timeout url")
 	case opt.ResultURL == "":
 		err = errs.MissingField("result url")
+	case opt.ReversalResultURL == "":
+		err = errs.MissingField("reversal result url")
 	}
 	return err
 }
@@ -144,23 +189,68 @@ func NewB2CAPI(ctx context.Context, opt *Options) (b2c.B2CV1Server, error) {
 		opt.B2COptions.ConsumerKey + ":" + opt.B2COptions.ConsumerSecret,
 	))
 
+	if opt.WebhookHTTPClient == nil {
+		opt.WebhookHTTPClient = &http.Client{Timeout: webhookRequestTimeout}
+	}
+	if opt.WebhookMaxAttempts <= 0 {
+		opt.WebhookMaxAttempts = defaultWebhookMaxAttempts
+	}
+	if opt.ChangeFeed == nil {
+		opt.ChangeFeed = NewChangeFeed(opt.RedisDB, 0, opt.Logger)
+	}
+	if opt.Shutdown == nil {
+		opt.Shutdown = shutdown.NewCoordinator()
+	}
+	if opt.Archive == nil {
+		opt.Archive = &ArchiveOptions{}
+	}
+	err = validateArchiveOptions(opt.Archive)
+	if err != nil {
+		return nil, err
+	}
+	if opt.PIIPolicy == nil {
+		opt.PIIPolicy = &PIIPolicy{}
+	}
+	if opt.PIIPolicy.AuthAPI == nil {
+		opt.PIIPolicy.AuthAPI = opt.AuthAPI
+	}
+	if opt.Leader == nil {
+		opt.Leader, err = leader.NewElector(opt.RedisDB, &leader.Options{Name: "b2c-workers", Logger: opt.Logger})
+		if err != nil {
+			return nil, err
+		}
+		go opt.Leader.Run(ctx)
+	}
+
 	b2cAPI := &b2cAPIServer{
-		Options: opt,
+		Options:    opt,
+		ctx:        ctx,
+		tokenState: &accessTokenState{},
 	}
 
-	// Auto migration
-	if !b2cAPI.SQLDB.Migrator().HasTable(&Payment{}) {
-		err = b2cAPI.SQLDB.Migrator().AutoMigrate(&Payment{})
+	// Schema migrations are applied by the migrate command when skipped
+	if !opt.SkipMigrations {
+		err = b2cAPI.migrateSchema(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = checkSchemaMigrated(ctx, b2cAPI.SQLDB)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if !b2cAPI.SQLDB.Migrator().HasTable(&DailyStat{}) {
-		err = b2cAPI.SQLDB.Migrator().AutoMigrate(&DailyStat{})
+	// Data migrations resume from their saved progress so they need not hold up the start of the service
+	go func() {
+		err := b2cAPI.migrateTransactionTimes(ctx)
 		if err != nil {
-			return nil, err
+			b2cAPI.Logger.Errorf("failed to migrate transaction times: %v", err)
 		}
+	}()
+
+	if opt.Health != nil {
+		b2cAPI.registerHealthChecks(opt.Health)
 	}
 
 	// Worker for updating access token
@@ -169,9 +259,33 @@ func NewB2CAPI(ctx context.Context, opt *Options) (b2c.B2CV1Server, error) {
 	// Worker to generate daily statistics
 	go b2cAPI.dailyDailyStatWorker(ctx)
 
+	// Worker to generate hourly statistics
+	go b2cAPI.hourlyStatWorker(ctx, 15*time.Minute)
+
+	// Worker to send pending webhook deliveries
+	go b2cAPI.webhookDeliveryWorker(ctx, 10*time.Second)
+
+	// Worker to archive payments older than the retention
+	if opt.Archive.RetentionMonths > 0 {
+		go b2cAPI.archivalWorker(ctx, opt.Archive.Interval)
+	}
+
 	return b2cAPI, nil
 }
 
+// addMissingColumns adds model fields that are missing from an existing table
+func addMissingColumns(sqlDB *gorm.DB, model interface{}, fields ...string) error {
+	for _, field := range fields {
+		if !sqlDB.Migrator().HasColumn(model, field) {
+			err := sqlDB.Migrator().AddColumn(model, field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // GetMpesaRequestKey is key that initiates data
 func GetMpesaRequestKey(requestId string) string {
 	return fmt.Sprintf("b2c:%s", requestId)
@@ -219,6 +333,12 @@ func (b2cAPI *b2cAPIServer) TransferFunds(
 		}
 	}
 
+	// Authorization
+	err = authorizeShortCode(ctx, req.ShortCode, req.InitiatorId)
+	if err != nil {
+		return nil, err
+	}
+
 	var commandID string
 	switch req.CommandId {
 	case b2c.CommandId_BUSINESS_PAYMENT:
@@ -265,74 +385,151 @@ func (b2cAPI *b2cAPIServer) TransferFunds(
 	reqHttp.Header.Set("Authorization", fmt.Sprintf("Bearer %s", b2cAPI.B2COptions.accessToken))
 	reqHttp.Header.Set("Content-Type", "application/json")
 
-	httputils.DumpRequest(reqHttp, "TransferFunds Request")
+	// Messages published to initiators without a group are masked
+	var initiatorGroup string
+	if payload, err := b2cAPI.AuthAPI.GetPayload(ctx); err == nil {
+		initiatorGroup = firstN(payload.Group, 50)
+	}
+
+	// Payment is saved once mpesa responds to the request
+	paymentDB := &Payment{
+		ID:                            0,
+		InitiatorID:                   req.InitiatorId,
+		InitiatorGroup:                initiatorGroup,
+		InitiatorCustomerReference:    req.InitiatorCustomerReference,
+		InitiatorCustomerNames:        req.InitiatorCustomerNames,
+		InitiatorTransactionReference: req.InitiatorTransactionReference,
+		Msisdn:                        fmt.Sprint(phone),
+		OrgShortCode:                  req.ShortCode,
+		CommandId:                     req.CommandId.String(),
+		TransactionAmount:             float32(req.Amount),
+		ResultCode:                    "",
+		ResultDescription:             "",
+		WorkingAccountFunds:           0,
+		UtilityAccountFunds:           0,
+		MpesaCharges:                  0,
+		SystemCharges:                 0,
+		RecipientRegistered:           false,
+		MpesaReceiptId:                sql.NullString{},
+		ReceiverPublicName:            "",
+		B2CStatus:                     b2c.B2CStatus_B2C_REQUEST_SUBMITED.String(),
+		Source:                        "",
+		Tag:                           "",
+		Succeeded:                     "",
+		Processed:                     "",
+		TransactionTime:               sql.NullTime{Valid: true, Time: time.Now().UTC()},
+		CreatedAt:                     time.Time{},
+	}
+
+	err = SetPublishInfo(paymentDB, req.Publish, req.PublishMessage)
+	if err != nil {
+		return nil, errs.WrapError(err)
+	}
+
+	// The submission outlives the request so it is tracked until the payment is saved
+	submitted, ok := b2cAPI.Shutdown.Begin(shutdown.KindTransfer, fmt.Sprintf(
+		"initiator=%s reference=%s short_code=%s amount=%v",
+		req.InitiatorId, req.InitiatorTransactionReference, req.ShortCode, req.Amount,
+	))
+	if !ok {
+		return nil, errs.WrapMessage(codes.Unavailable, "service is shutting down; retry the transfer")
+	}
+
+	// The submission outlives the request but stays in its trace
+	spanCtx := trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+	if httputils.IsDebug(ctx) {
+		spanCtx = httputils.WithDebug(spanCtx)
+	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer submitted()
+
+		ctx, span := tracing.Tracer().Start(spanCtx, "b2c.SubmitTransfer", trace.WithAttributes(
+			attribute.String("b2c.short_code", req.ShortCode),
+			attribute.String("b2c.command_id", req.CommandId.String()),
+		))
+		defer span.End()
+
+		paymentDB.TraceParent = tracing.TraceParent(ctx)
+
+		// Records requests that mpesa did not accept
+		submitFailed := func(reason string) {
+			metrics.Transfers.WithLabelValues(req.ShortCode, req.CommandId.String(), metrics.TransferRejected).Inc()
+
+			span.SetStatus(otelcodes.Error, reason)
+
+			paymentDB.B2CStatus = b2c.B2CStatus_B2C_REQUEST_FAILED.String()
+			paymentDB.ResponseDescription = firstN(reason, 300)
+			paymentDB.Succeeded = "NO"
+
+			err := b2cAPI.SQLDB.WithContext(ctx).Create(paymentDB).Error
+			if err != nil {
+				b2cAPI.Logger.Errorln("Failed to create failed b2c payload: ", err)
+				return
+			}
+			b2cAPI.EmitPaymentEvent(ctx, b2c.B2CEventType_B2C_PAYMENT_SUBMIT_FAILED, paymentDB)
+		}
+
+		metrics.Transfers.WithLabelValues(req.ShortCode, req.CommandId.String(), metrics.TransferSubmitted).Inc()
+
+		// Only the request to mpesa is bounded; the outcome is saved however long mpesa took
+		reqCtx, cancel := context.WithTimeout(ctx, transferRequestTimeout)
 		defer cancel()
 
-		res, err := b2cAPI.HTTPClient.Do(reqHttp)
+		res, err := b2cAPI.doDaraja(metrics.EndpointB2C, reqHttp.WithContext(reqCtx))
 		if err != nil {
 			b2cAPI.Logger.Errorf("failed to post transfer request to mpesa API: %v", err)
+			submitFailed(fmt.Sprintf("failed to post transfer request: %v", err))
 			return
 		}
-
-		httputils.DumpResponse(res, "TransferFunds Response")
+		defer res.Body.Close()
 
 		apiRes := &payload.GenericAPIResponse{}
 
 		err = json.NewDecoder(res.Body).Decode(&apiRes.Response)
 		if err != nil && err != io.EOF {
 			b2cAPI.Logger.Errorf("failed to decode mpesa response: %v", err)
+			submitFailed(fmt.Sprintf("failed to decode mpesa response: %v", err))
 			return
 		}
 
 		errMsg, ok := apiRes.Response["errorMessage"]
 		if ok {
 			b2cAPI.Logger.Errorf("error happened while sending transfer funds request: %v", errMsg)
+			paymentDB.ResponseCode = fmt.Sprint(apiRes.Response["errorCode"])
+			submitFailed(fmt.Sprint(errMsg))
 			return
 		}
 
-		switch strings.ToLower(res.Header.Get("content-type")) {
-		case "application/json", "application/json;charset=utf-8":
-			var (
-				convId     = fmt.Sprint(apiRes.Response["ConversationID"])
-				origConvId = fmt.Sprint(apiRes.Response["OriginatorConversationID"])
-			)
+		// Mpesa accepted the request when it returns a conversation id with a zero response code
+		var (
+			convId          = apiRes.Response["ConversationID"]
+			origConvId      = apiRes.Response["OriginatorConversationID"]
+			responseCode    = apiRes.Response["ResponseCode"]
+			mediaType, _, _ = mime.ParseMediaType(res.Header.Get("content-type"))
+		)
+
+		switch {
+		case convId != "" && responseCode == "0":
+			if mediaType != "application/json" {
+				b2cAPI.Logger.Warningf("mpesa accepted transfer %s with content type %q", convId, res.Header.Get("content-type"))
+			}
+
+			metrics.Transfers.WithLabelValues(req.ShortCode, req.CommandId.String(), metrics.TransferAccepted).Inc()
 
 			// Save the request to database
-			err = b2cAPI.SQLDB.Create(&Payment{
-				ID:                         0,
-				InitiatorID:                req.InitiatorId,
-				InitiatorCustomerReference: req.InitiatorCustomerReference,
-				InitiatorCustomerNames:     req.InitiatorCustomerNames,
-				Msisdn:                     fmt.Sprint(phone),
-				OrgShortCode:               req.ShortCode,
-				CommandId:                  req.CommandId.String(),
-				TransactionAmount:          float32(req.Amount),
-				ConversationID:             convId,
-				OriginatorConversationID:   origConvId,
-				ResponseDescription:        fmt.Sprint(apiRes.Response["ResponseDescription"]),
-				ResponseCode:               fmt.Sprint(apiRes.Response["ResponseCode"]),
-				ResultCode:                 "",
-				ResultDescription:          "",
-				WorkingAccountFunds:        0,
-				UtilityAccountFunds:        0,
-				MpesaCharges:               0,
-				SystemCharges:              0,
-				RecipientRegistered:        false,
-				MpesaReceiptId:             sql.NullString{},
-				ReceiverPublicName:         "",
-				B2CStatus:                  b2c.B2CStatus_B2C_REQUEST_SUBMITED.String(),
-				Source:                     "",
-				Tag:                        "",
-				Succeeded:                  "",
-				Processed:                  "",
-				TransactionTime:            sql.NullTime{Valid: true, Time: time.Now().UTC()},
-				CreatedAt:                  time.Time{},
-			}).Error
+			paymentDB.ConversationID = convId
+			paymentDB.OriginatorConversationID = origConvId
+			paymentDB.ResponseDescription = apiRes.Response["ResponseDescription"]
+			paymentDB.ResponseCode = responseCode
+
+			span.SetAttributes(attribute.String("b2c.conversation_id", convId))
+
+			err = b2cAPI.SQLDB.WithContext(ctx).Create(paymentDB).Error
 			if err != nil {
 				b2cAPI.Logger.Errorln("Failed to create b2c payload: ", err)
+			} else {
+				b2cAPI.EmitPaymentEvent(ctx, b2c.B2CEventType_B2C_PAYMENT_SUBMITTED, paymentDB)
 			}
 
 			// Marshal req
@@ -342,6 +539,13 @@ func (b2cAPI *b2cAPIServer) TransferFunds(
 				return
 			}
 
+			// The request carries the msisdn and customer names
+			bs, err = pii.Seal(bs)
+			if err != nil {
+				b2cAPI.Logger.Errorln("Failed to encrypt transferFunds request: ", err)
+				return
+			}
+
 			requestId := GetMpesaRequestKey(convId)
 
 			// Save in cache
@@ -351,6 +555,16 @@ func (b2cAPI *b2cAPIServer) TransferFunds(
 				return
 			}
 		default:
+			// Results of requests that mpesa gave a conversation id to can still be matched
+			paymentDB.ConversationID = convId
+			paymentDB.OriginatorConversationID = origConvId
+			paymentDB.ResponseCode = responseCode
+
+			if mediaType != "application/json" {
+				submitFailed(fmt.Sprintf("unexpected response content type %q", res.Header.Get("content-type")))
+				return
+			}
+			submitFailed(fmt.Sprintf("mpesa did not accept the request: %s", apiRes.Response["ResponseDescription"]))
 		}
 	}()
 
@@ -377,13 +591,28 @@ func (b2cAPI *b2cAPIServer) GetB2CPayment(
 		key, _ = strconv.Atoi(req.PaymentId)
 	}
 
+	fields, err := paymentFields(req.View, req.ReadMask)
+	if err != nil {
+		return nil, err
+	}
+
 	db := &Payment{}
 
+	// Scope of the caller is checked on the short code and initiator
+	tx := selectPaymentColumns(b2cAPI.SQLDB, fields, "id", "org_short_code", "initiator_id")
+
 	if !req.IsMpesaId {
-		err = b2cAPI.SQLDB.First(db, "id=?", key).Error
+		err = tx.First(db, "id=?", key).Error
 	} else {
-		err = b2cAPI.SQLDB.First(db, "mpesa_receipt_id=?", req.PaymentId).Error
+		err = tx.First(db, "mpesa_receipt_id=?", req.PaymentId).Error
 	}
+
+	archived := false
+	if errors.Is(err, gorm.ErrRecordNotFound) && req.IncludeArchived {
+		db, err = b2cAPI.getArchivedPayment(ctx, req.PaymentId, req.IsMpesaId)
+		archived = err == nil
+	}
+
 	switch {
 	case err == nil:
 	case errors.Is(err, gorm.ErrRecordNotFound):
@@ -393,7 +622,30 @@ func (b2cAPI *b2cAPIServer) GetB2CPayment(
 		return nil, errs.WrapMessage(codes.Internal, "failed to get b2c payment")
 	}
 
-	return PaymentProto(db)
+	err = authorizePayment(ctx, db, req.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	pb, err := PaymentProto(db)
+	if err != nil {
+		return nil, err
+	}
+
+	maskPayment(pb, fields)
+
+	access := b2cAPI.piiAccess(ctx)
+	access.MaskPayment(pb)
+
+	err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "GetB2CPayment", fmt.Sprintf("payment_id=%s", req.PaymentId), uint64(db.ID))
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get b2c payment")
+	}
+
+	pb.Archived = archived
+
+	return pb, nil
 }
 
 func (b2cAPI *b2cAPIServer) QueryTransactionStatus(
@@ -419,6 +671,12 @@ func (b2cAPI *b2cAPIServer) QueryAccountBalance(
 		return nil, errs.MissingField("identifier type")
 	}
 
+	// Authorization
+	err := authorizeShortCode(ctx, fmt.Sprint(req.PartyA), req.InitiatorId)
+	if err != nil {
+		return nil, err
+	}
+
 	// Send the request to safaricom
 	queryBalPayload := &payload.AccountBalanceRequest{
 		CommandID:          "AccountBalance",
@@ -438,7 +696,7 @@ func (b2cAPI *b2cAPIServer) QueryAccountBalance(
 	}
 
 	// Create request
-	reqHttp, err := http.NewRequest(http.MethodPost, b2cAPI.QueryBalanceURL, bytes.NewReader(bs))
+	reqHttp, err := http.NewRequestWithContext(ctx, http.MethodPost, b2cAPI.QueryBalanceURL, bytes.NewReader(bs))
 	if err != nil {
 		return nil, errs.WrapMessage(codes.Internal, "failed to create request to query balance")
 	}
@@ -447,16 +705,12 @@ func (b2cAPI *b2cAPIServer) QueryAccountBalance(
 	reqHttp.Header.Set("Authorization", fmt.Sprintf("Bearer %s", b2cAPI.B2COptions.accessToken))
 	reqHttp.Header.Set("Content-Type", "application/json")
 
-	httputils.DumpRequest(reqHttp, "QueryAccountBalance Request")
-
 	// Post to MPESA API
-	res, err := b2cAPI.HTTPClient.Do(reqHttp)
+	res, err := b2cAPI.doDaraja(metrics.EndpointQueryBalance, reqHttp)
 	if err != nil {
 		return nil, errs.WrapError(err)
 	}
 
-	httputils.DumpResponse(res, "QueryAccountBalance Response")
-
 	apiRes := &payload.GenericAPIResponse{}
 
 	err = json.NewDecoder(res.Body).Decode(&apiRes.Response)
@@ -494,6 +748,70 @@ func (b2cAPI *b2cAPIServer) ReverseTransaction(
 		return nil, errs.MissingField("remarks")
 	}
 
+	// Authorization
+	if !rbac.FromContext(ctx).AllowsShortCode(fmt.Sprint(reverseReq.ShortCode)) {
+		return nil, errs.WrapMessage(codes.PermissionDenied, fmt.Sprintf("not allowed to act for short code %d", reverseReq.ShortCode))
+	}
+
+	// Claim the payment so that it is reversed once
+	paymentDB := &Payment{}
+	err := b2cAPI.SQLDB.WithContext(ctx).First(paymentDB, "mpesa_receipt_id = ?", reverseReq.TransactionId).Error
+	switch {
+	case err == nil:
+		// The receipt must be of a payment of the short code the caller acts for
+		err = authorizePayment(ctx, paymentDB, reverseReq.TransactionId)
+		if err != nil {
+			return nil, err
+		}
+		if paymentDB.OrgShortCode != fmt.Sprint(reverseReq.ShortCode) {
+			return nil, errs.DoesNotExist("b2c transaction", reverseReq.TransactionId)
+		}
+
+		res := b2cAPI.SQLDB.WithContext(ctx).Model(paymentDB).
+			Where("b2c_status = ?", b2c.B2CStatus_B2C_SUCCESS.String()).
+			Update("b2c_status", b2c.B2CStatus_B2C_REVERSAL_PENDING.String())
+		if res.Error != nil {
+			b2cAPI.Logger.Errorln(res.Error)
+			return nil, errs.WrapMessage(codes.Internal, "failed to update payment")
+		}
+		if res.RowsAffected == 0 {
+			return nil, errs.WrapMessage(
+				codes.FailedPrecondition, fmt.Sprintf("payment with status %s cannot be reversed", paymentDB.B2CStatus),
+			)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		b2cAPI.Logger.Warningf("reversed transaction %s has no b2c payment", reverseReq.TransactionId)
+		paymentDB = nil
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get payment")
+	}
+
+	conversationID, err := b2cAPI.requestReversal(ctx, reverseReq)
+	if err != nil {
+		if paymentDB != nil {
+			err2 := b2cAPI.SQLDB.Model(paymentDB).Update("b2c_status", b2c.B2CStatus_B2C_SUCCESS.String()).Error
+			if err2 != nil {
+				b2cAPI.Logger.Errorf("failed to release payment %d after failed reversal: %v", paymentDB.ID, err2)
+			}
+		}
+		return nil, err
+	}
+
+	// The payment is reversed once the result of the reversal is received
+	if paymentDB != nil {
+		err = b2cAPI.SQLDB.Model(paymentDB).Update("reversal_conversation_id", conversationID).Error
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to update payment")
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// requestReversal sends the reversal to mpesa and returns its conversation id
+func (b2cAPI *b2cAPIServer) requestReversal(ctx context.Context, reverseReq *b2c.ReverseTransactionRequest) (string, error) {
 	// Send the request to mpesa API
 	reverseRequest := &payload.ReversalRequest{
 		CommandID:              "TransactionReversal",
@@ -502,6 +820,8 @@ func (b2cAPI *b2cAPIServer) ReverseTransaction(
 		Remarks:                reverseReq.Remarks,
 		Initiator:              b2cAPI.Options.B2COptions.InitiatorUsername,
 		SecurityCredential:     b2cAPI.B2COptions.InitiatorEncryptedPassword,
+		QueueTimeOutURL:        b2cAPI.B2COptions.ReversalResultURL,
+		ResultURL:              b2cAPI.B2COptions.ReversalResultURL,
 		TransactionID:          reverseReq.TransactionId,
 		Occassion:              reverseReq.Occassion,
 	}
@@ -509,52 +829,45 @@ func (b2cAPI *b2cAPIServer) ReverseTransaction(
 	// Json Marshal
 	bs, err := json.Marshal(reverseRequest)
 	if err != nil {
-		return nil, errs.FromJSONMarshal(err, "b2cPayload")
+		return "", errs.FromJSONMarshal(err, "b2cPayload")
 	}
 
 	// Create request
-	req, err := http.NewRequest(http.MethodPost, b2cAPI.ReversalURL, bytes.NewReader(bs))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b2cAPI.ReversalURL, bytes.NewReader(bs))
 	if err != nil {
-		return nil, errs.WrapMessage(codes.Internal, "failed to create new request")
+		return "", errs.WrapMessage(codes.Internal, "failed to create new request")
 	}
 
 	// Update headers
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", b2cAPI.B2COptions.accessToken))
 	req.Header.Set("Content-Type", "application/json")
 
-	httputils.DumpRequest(req, "ReverseTransaction Request")
-
 	// Post to MPESA API
-	res, err := b2cAPI.HTTPClient.Do(req)
+	res, err := b2cAPI.doDaraja(metrics.EndpointReversal, req)
 	if err != nil {
-		return nil, errs.WrapError(err)
+		return "", errs.WrapError(err)
 	}
-
-	httputils.DumpResponse(res, "ReverseTransaction Response")
+	defer res.Body.Close()
 
 	apiRes := &payload.GenericAPIResponse{}
 
 	err = json.NewDecoder(res.Body).Decode(&apiRes.Response)
 	if err != nil && err != io.EOF {
-		return nil, errs.WrapError(err)
+		return "", errs.WrapError(err)
 	}
 
 	if !apiRes.Succeeded() {
-		return nil, errs.WrapMessage(codes.Unknown, apiRes.Error())
+		return "", errs.WrapMessage(codes.Unknown, apiRes.Error())
 	}
 
-	return &emptypb.Empty{}, nil
+	return apiRes.ConversationID(), nil
 }
 
 const defaultPageSize = 20
 
+// getTime parses a date in the format 2006-01-02 to the start of the day in the reporting timezone
 func getTime(dateStr string) (time.Time, error) {
-	// 2020y 08m 16d 20h 41m 16s
-	// "2006-01-02T15:04:05Z07:00"
-
-	timeRFC3339Str := fmt.Sprintf("%sT00:00:00Z", dateStr)
-
-	t, err := time.Parse(time.RFC3339, timeRFC3339Str)
+	t, err := timeutil.ParseReportingDate(dateStr)
 	if err != nil {
 		return time.Time{}, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse date to time")
 	}
@@ -588,94 +901,25 @@ func (b2cAPI *b2cAPIServer) ListB2CPayments(
 		pageSize = defaultPageSize
 	}
 
-	var key string
+	fields, err := paymentFields(req.View, req.ReadMask)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor *paymentCursor
 
 	pageToken := req.GetPageToken()
 	if pageToken != "" {
-		bs, err := base64.StdEncoding.DecodeString(req.GetPageToken())
+		cursor, err = parsePaymentCursor(pageToken)
 		if err != nil {
 			return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
 		}
-		key = string(bs)
-	}
-
-	db := b2cAPI.SQLDB.Model(&Payment{}).Limit(int(pageSize + 1))
-	if key != "" {
-		switch req.GetFilter().GetOrderField() {
-		case b2c.B2COrderField_B2C_PAYMENT_ID:
-			db = db.Order("id DESC").Where("id<?", key)
-		case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
-			db = db.Order("transaction_time DESC").Where("transaction_time<?", key)
-		}
-	} else {
-		switch req.GetFilter().GetOrderField() {
-		case b2c.B2COrderField_B2C_PAYMENT_ID:
-			db = db.Order("id DESC")
-		case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
-			db = db.Order("transaction_time DESC")
-		}
 	}
 
 	// Apply filters
-	if req.Filter != nil {
-		startTimestamp := req.Filter.GetStartTimestamp()
-		endTimestamp := req.Filter.GetEndTimestamp()
-
-		if endTimestamp > startTimestamp {
-			switch req.GetFilter().GetOrderField() {
-			case b2c.B2COrderField_B2C_PAYMENT_ID:
-				db = db.Where("created_at BETWEEN ? AND ?", time.Unix(startTimestamp, 0), time.Unix(endTimestamp, 0))
-			case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
-				db = db.Where("transaction_time BETWEEN ? AND ?", time.Unix(startTimestamp, 0), time.Unix(endTimestamp, 0))
-			}
-		} else if req.Filter.TxDate != "" {
-			t, err := getTime(req.Filter.TxDate)
-			if err != nil {
-				return nil, err
-			}
-			switch req.GetFilter().GetOrderField() {
-			case b2c.B2COrderField_B2C_PAYMENT_ID:
-				db = db.Where("created_at BETWEEN ? AND ?", t, t.Add(time.Hour*24))
-			case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
-				db = db.Where("transaction_time BETWEEN ? AND ?", t, t.Add(time.Hour*24))
-			}
-		}
-
-		if len(req.Filter.Msisdns) > 0 {
-			db = db.Where("msisdn IN(?)", req.Filter.Msisdns)
-		}
-
-		if len(req.Filter.MpesaReceipts) > 0 {
-			db = db.Where("mpesa_receipt_id IN(?)", req.Filter.MpesaReceipts)
-		}
-
-		if len(req.Filter.InitiatorCustomerReferences) > 0 {
-			db = db.Where("initiator_customer_reference IN(?)", req.Filter.InitiatorCustomerReferences)
-		}
-
-		if len(req.Filter.InitiatorTransactionReferences) > 0 {
-			db = db.Where("initiator_transaction_reference IN(?)", req.Filter.InitiatorTransactionReferences)
-		}
-
-		if len(req.Filter.ShortCodes) > 0 {
-			db = db.Where("org_short_code IN(?)", req.Filter.ShortCodes)
-		}
-
-		if len(req.Filter.B2CStatuses) > 0 {
-			ss := make([]string, 0, len(req.Filter.B2CStatuses))
-			for _, s := range req.Filter.B2CStatuses {
-				ss = append(ss, s.String())
-			}
-			db = db.Where("b2c_status IN(?)", ss)
-		}
-
-		switch req.Filter.ProcessState {
-		case b2c.B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED:
-		case b2c.B2CProcessedState_B2C_NOT_PROCESSED:
-			db = db.Where("processed=false")
-		case b2c.B2CProcessedState_B2C_PROCESSED:
-			db = db.Where("processed=true")
-		}
+	db, err := filterPayments(scopePayments(ctx, b2cAPI.SQLDB.Model(&Payment{})), req.Filter)
+	if err != nil {
+		return nil, err
 	}
 
 	var collectionCount int64
@@ -688,6 +932,14 @@ func (b2cAPI *b2cAPIServer) ListB2CPayments(
 		}
 	}
 
+	db, err = orderPayments(db, req.Filter, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	// Page tokens need the id and the order column
+	db = selectPaymentColumns(db.Limit(int(pageSize+1)), fields, "id", paymentOrderColumn(req.GetFilter().GetOrderField()))
+
 	txs := make([]*Payment, 0, pageSize+1)
 
 	err = db.Find(&txs).Error
@@ -699,6 +951,8 @@ func (b2cAPI *b2cAPIServer) ListB2CPayments(
 	}
 
 	pbs := make([]*b2c.B2CPayment, 0, len(txs))
+	ids := make([]uint64, 0, len(txs))
+	access := b2cAPI.PIIPolicy.Access(payload)
 
 	for i, db := range txs {
 		pb, err := PaymentProto(db)
@@ -706,23 +960,33 @@ func (b2cAPI *b2cAPIServer) ListB2CPayments(
 			return nil, err
 		}
 
+		maskPayment(pb, fields)
+		access.MaskPayment(pb)
+
 		if i == int(pageSize) {
 			break
 		}
 
 		pbs = append(pbs, pb)
-		switch req.GetFilter().GetOrderField() {
-		case b2c.B2COrderField_B2C_PAYMENT_ID:
-			key = fmt.Sprint(db.ID)
-		case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
-			key = db.TransactionTime.Time.UTC().Format(time.RFC3339)
+		ids = append(ids, uint64(db.ID))
+		cursor = newPaymentCursor(db, req.GetFilter().GetOrderField())
+	}
+
+	if len(pbs) > 0 {
+		err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "ListB2CPayments", "", ids...)
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to get b2c transactions")
 		}
 	}
 
 	var token string
 	if len(txs) > int(pageSize) {
 		// Next page token
-		token = base64.StdEncoding.EncodeToString([]byte(key))
+		token, err = cursor.token()
+		if err != nil {
+			return nil, errs.WrapErrorWithCodeAndMsg(codes.Internal, err, "failed to generate page token")
+		}
 	}
 
 	return &b2c.ListB2CPaymentsResponse{
@@ -732,6 +996,238 @@ func (b2cAPI *b2cAPIServer) ListB2CPayments(
 	}, nil
 }
 
+// paymentCursor is the position of the last payment in a page.
+//
+// The order value is paired with the id so that payments sharing the value are not skipped between pages.
+type paymentCursor struct {
+	Value string `json:"v,omitempty"`
+	ID    uint   `json:"id"`
+}
+
+func newPaymentCursor(db *Payment, orderField b2c.B2COrderField) *paymentCursor {
+	cursor := &paymentCursor{ID: db.ID}
+	switch orderField {
+	case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
+		cursor.Value = db.TransactionTime.Time.UTC().Format(time.RFC3339Nano)
+	case b2c.B2COrderField_B2C_AMOUNT:
+		cursor.Value = strconv.FormatFloat(float64(db.TransactionAmount), 'f', -1, 32)
+	}
+	return cursor
+}
+
+func parsePaymentCursor(pageToken string) (*paymentCursor, error) {
+	bs, err := base64.StdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &paymentCursor{}
+	err = json.Unmarshal(bs, cursor)
+	if err != nil {
+		return nil, err
+	}
+	return cursor, nil
+}
+
+func (cursor *paymentCursor) token() (string, error) {
+	bs, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bs), nil
+}
+
+// paymentOrderColumn returns the column payments are ordered by
+func paymentOrderColumn(orderField b2c.B2COrderField) string {
+	switch orderField {
+	case b2c.B2COrderField_B2C_TRANSACTION_TIMESTAMP:
+		return "transaction_time"
+	case b2c.B2COrderField_B2C_AMOUNT:
+		return "transaction_amount"
+	}
+	return "id"
+}
+
+// orderPayments orders the payments query as requested by the filter starting after the cursor
+func orderPayments(db *gorm.DB, filter *b2c.ListB2CPaymentFilter, cursor *paymentCursor) (*gorm.DB, error) {
+	direction, op := "DESC", "<"
+	if filter.GetOrderDirection() == b2c.B2COrderDirection_B2C_ORDER_ASC {
+		direction, op = "ASC", ">"
+	}
+
+	column := paymentOrderColumn(filter.GetOrderField())
+
+	var value interface{}
+
+	switch column {
+	case "transaction_time":
+		if cursor != nil {
+			t, err := time.Parse(time.RFC3339Nano, cursor.Value)
+			if err != nil {
+				return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+			}
+			value = t
+		}
+	case "transaction_amount":
+		if cursor != nil {
+			v, err := strconv.ParseFloat(cursor.Value, 32)
+			if err != nil {
+				return nil, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+			}
+			// Floats need not compare equal after a round trip through text so the stored amount of the
+			// cursor payment is used, falling back to the token value when the payment is gone
+			stored := db.Session(&gorm.Session{NewDB: true}).Model(&Payment{}).Select(column).Where("id = ?", cursor.ID)
+			value = gorm.Expr("COALESCE((?), ?)", stored, v)
+		}
+	default:
+		db = db.Order("id " + direction)
+		if cursor != nil {
+			db = db.Where("id "+op+" ?", cursor.ID)
+		}
+		return db, nil
+	}
+
+	db = db.Order(column + " " + direction).Order("id " + direction)
+	if cursor != nil {
+		db = db.Where(
+			fmt.Sprintf("(%s %s ? OR (%s = ? AND id %s ?))", column, op, column, op), value, value, cursor.ID,
+		)
+	}
+
+	return db, nil
+}
+
+// likeEscape is the escape character of LIKE patterns; it is bound as databases quote backslashes differently
+const likeEscape = `\`
+
+// escapes wildcards in a LIKE pattern; queries must use it with ESCAPE likeEscape
+func likePattern(v string) string {
+	v = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(v)
+	return "%" + v + "%"
+}
+
+// filterPayments applies the list filter to the payments query
+func filterPayments(db *gorm.DB, filter *b2c.ListB2CPaymentFilter) (*gorm.DB, error) {
+	if filter == nil {
+		return db, nil
+	}
+
+	// Payments ordered by id are filtered by their creation time
+	timeColumn := "transaction_time"
+	switch filter.GetOrderField() {
+	case b2c.B2COrderField_B2C_ORDER_FIELD_UNSPECIFIED, b2c.B2COrderField_B2C_PAYMENT_ID:
+		timeColumn = "created_at"
+	}
+
+	startTimestamp := filter.GetStartTimestamp()
+	endTimestamp := filter.GetEndTimestamp()
+
+	if endTimestamp > startTimestamp {
+		db = db.Where(timeColumn+" BETWEEN ? AND ?", time.Unix(startTimestamp, 0), time.Unix(endTimestamp, 0))
+	} else if filter.TxDate != "" {
+		t, err := getTime(filter.TxDate)
+		if err != nil {
+			return nil, err
+		}
+		db = db.Where(timeColumn+" BETWEEN ? AND ?", t, t.Add(time.Hour*24))
+	}
+
+	if len(filter.Msisdns) > 0 {
+		if pii.Enabled() {
+			// Encrypted msisdns are looked up through their blind index
+			indexes := make([]string, 0, len(filter.Msisdns))
+			for _, msisdn := range filter.Msisdns {
+				indexes = append(indexes, pii.BlindIndex(msisdn))
+			}
+			db = db.Where("msisdn_index IN(?)", indexes)
+		} else {
+			db = db.Where("msisdn IN(?)", filter.Msisdns)
+		}
+	}
+
+	if len(filter.MpesaReceipts) > 0 {
+		db = db.Where("mpesa_receipt_id IN(?)", filter.MpesaReceipts)
+	}
+
+	if len(filter.InitiatorIds) > 0 {
+		db = db.Where("initiator_id IN(?)", filter.InitiatorIds)
+	}
+
+	if len(filter.InitiatorCustomerReferences) > 0 {
+		db = db.Where("initiator_customer_reference IN(?)", filter.InitiatorCustomerReferences)
+	}
+
+	if len(filter.InitiatorTransactionReferences) > 0 {
+		db = db.Where("initiator_transaction_reference IN(?)", filter.InitiatorTransactionReferences)
+	}
+
+	if len(filter.ShortCodes) > 0 {
+		db = db.Where("org_short_code IN(?)", filter.ShortCodes)
+	}
+
+	if len(filter.B2CStatuses) > 0 {
+		ss := make([]string, 0, len(filter.B2CStatuses))
+		for _, s := range filter.B2CStatuses {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("b2c_status IN(?)", ss)
+	}
+
+	if len(filter.CommandIds) > 0 {
+		ss := make([]string, 0, len(filter.CommandIds))
+		for _, s := range filter.CommandIds {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("command_id IN(?)", ss)
+	}
+
+	if filter.AmountMin > 0 {
+		db = db.Where("transaction_amount >= ?", filter.AmountMin)
+	}
+
+	if filter.AmountMax > 0 {
+		db = db.Where("transaction_amount <= ?", filter.AmountMax)
+	}
+
+	// Encrypted names cannot be matched in the database
+	if pii.Enabled() && (filter.InitiatorCustomerNames != "" || filter.ReceiverPartyPublicName != "") {
+		return nil, errs.WrapMessage(codes.InvalidArgument, "customer and receiver name filters are unavailable as names are encrypted")
+	}
+
+	if filter.InitiatorCustomerNames != "" {
+		db = db.Where("initiator_customer_names LIKE ? ESCAPE ?", likePattern(filter.InitiatorCustomerNames), likeEscape)
+	}
+
+	if filter.ReceiverPartyPublicName != "" {
+		db = db.Where("receiver_public_name LIKE ? ESCAPE ?", likePattern(filter.ReceiverPartyPublicName), likeEscape)
+	}
+
+	switch filter.ProcessState {
+	case b2c.B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED:
+	case b2c.B2CProcessedState_B2C_NOT_PROCESSED:
+		db = db.Where("processed = ?", "NO")
+	case b2c.B2CProcessedState_B2C_PROCESSED:
+		db = db.Where("processed = ?", "YES")
+	}
+
+	switch filter.SucceededState {
+	case b2c.B2CSucceededState_B2C_SUCCEEDED_STATE_UNSPECIFIED:
+	case b2c.B2CSucceededState_B2C_NOT_SUCCEEDED:
+		db = db.Where("succeeded <> ?", "YES")
+	case b2c.B2CSucceededState_B2C_SUCCEEDED:
+		db = db.Where("succeeded = ?", "YES")
+	}
+
+	switch filter.RecipientState {
+	case b2c.B2CRecipientState_B2C_RECIPIENT_STATE_UNSPECIFIED:
+	case b2c.B2CRecipientState_B2C_RECIPIENT_NOT_REGISTERED:
+		db = db.Where("recipient_registered = ?", false)
+	case b2c.B2CRecipientState_B2C_RECIPIENT_REGISTERED:
+		db = db.Where("recipient_registered = ?", true)
+	}
+
+	return db, nil
+}
+
 func (b2cAPI *b2cAPIServer) ProcessB2CPayment(
 	ctx context.Context, req *b2c.ProcessB2CPaymentRequest,
 ) (*emptypb.Empty, error) {
@@ -750,6 +1246,29 @@ func (b2cAPI *b2cAPIServer) ProcessB2CPayment(
 		key, _ = strconv.Atoi(req.PaymentId)
 	}
 
+	// Authorization
+	if !rbac.FromContext(ctx).Unrestricted() {
+		db := &Payment{}
+		tx := b2cAPI.SQLDB.Select("id", "org_short_code", "initiator_id")
+		if key != 0 {
+			err = tx.First(db, "id=?", key).Error
+		} else {
+			err = tx.First(db, "mpesa_receipt_id=?", req.PaymentId).Error
+		}
+		switch {
+		case err == nil:
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, errs.DoesNotExist("b2c transaction", req.PaymentId)
+		default:
+			b2cAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to process b2c transaction")
+		}
+		err = authorizePayment(ctx, db, req.PaymentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if key != 0 {
 		err = b2cAPI.SQLDB.Model(&Payment{}).Unscoped().Where("id=?", key).
 			Update("processed", req.Processed).Error
@@ -764,6 +1283,27 @@ func (b2cAPI *b2cAPIServer) ProcessB2CPayment(
 		return nil, errs.WrapMessage(codes.Internal, "failed to process b2c transaction")
 	}
 
+	paymentDB := &Payment{}
+	if key != 0 {
+		err = b2cAPI.SQLDB.First(paymentDB, "id=?", key).Error
+	} else {
+		err = b2cAPI.SQLDB.First(paymentDB, "mpesa_receipt_id=?", req.PaymentId).Error
+	}
+	switch {
+	case err != nil:
+		b2cAPI.Logger.Errorf("failed to get processed payment: %v", err)
+	case req.Processed:
+		b2cAPI.EmitPaymentEvent(ctx, b2c.B2CEventType_B2C_PAYMENT_PROCESSED, paymentDB)
+	default:
+		pb, err := PaymentProto(paymentDB)
+		if err == nil {
+			_, err = b2cAPI.ChangeFeed.Append(ctx, pb)
+		}
+		if err != nil {
+			b2cAPI.Logger.Errorf("failed to append payment %d to change feed: %v", paymentDB.ID, err)
+		}
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -778,6 +1318,19 @@ func (b2cAPI *b2cAPIServer) PublishB2CPayment(
 		return nil, errs.MissingField("publish message")
 	}
 
+	// Authorization
+	err := authorizeShortCode(ctx, req.PublishMessage.GetPayment().GetOrgShortCode(), req.PublishMessage.GetInitiatorId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Recipients of the message see what the caller sees
+	access := b2cAPI.piiAccess(ctx)
+	access.MaskPayment(req.PublishMessage.Payment)
+	if !access.Unmasked {
+		req.PublishMessage.Msisdn = MaskMsisdn(req.PublishMessage.Msisdn)
+	}
+
 	pb := req.GetPublishMessage().GetPayment()
 
 	// Marshal publish message
@@ -795,34 +1348,57 @@ func (b2cAPI *b2cAPIServer) PublishB2CPayment(
 	// Publish based on state
 	switch req.ProcessedState {
 	case b2c.B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED:
-		err = b2cAPI.RedisDB.Publish(ctx, channel, bs).Err()
-		if err != nil {
-			b2cAPI.Logger.Errorln(err)
-			return nil, errs.WrapMessage(codes.Internal, "command failed")
-		}
+		err = b2cAPI.publish(ctx, channel, req.PublishMessage, bs)
 	case b2c.B2CProcessedState_B2C_NOT_PROCESSED:
 		// Publish only if the processed state is false
 		if !pb.GetProcessed() {
-			err = b2cAPI.RedisDB.Publish(ctx, channel, bs).Err()
-			if err != nil {
-				b2cAPI.Logger.Errorln(err)
-				return nil, errs.WrapMessage(codes.Internal, "command failed")
-			}
+			err = b2cAPI.publish(ctx, channel, req.PublishMessage, bs)
 		}
 	case b2c.B2CProcessedState_B2C_PROCESSED:
 		// Publish only if the processed state is true
 		if pb.GetProcessed() {
-			err = b2cAPI.RedisDB.Publish(ctx, channel, bs).Err()
-			if err != nil {
-				b2cAPI.Logger.Errorln(err)
-				return nil, errs.WrapMessage(codes.Internal, "command failed")
-			}
+			err = b2cAPI.publish(ctx, channel, req.PublishMessage, bs)
+		}
+	}
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "command failed")
+	}
+
+	if pb.GetTransactionId() != 0 {
+		err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "PublishB2CPayment", fmt.Sprintf("channel=%s", channel), pb.GetTransactionId())
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
 		}
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// publish sends the message on the channel using the transport chosen by the publish info
+func (b2cAPI *b2cAPIServer) publish(ctx context.Context, channel string, msg *b2c.PublishMessage, bs []byte) error {
+	if b2cAPI.useStream(msg.GetPublishInfo()) {
+		err := b2cAPI.appendToStream(ctx, channel, msg, bs)
+		if err != nil {
+			metrics.PublishFailures.WithLabelValues("stream").Inc()
+		}
+		return err
+	}
+	err := b2cAPI.RedisDB.Publish(ctx, channel, bs).Err()
+	if err != nil {
+		metrics.PublishFailures.WithLabelValues("pubsub").Inc()
+	}
+	return err
+}
+
+// doDaraja sends the request to the daraja endpoint recording its latency and status
+func (b2cAPI *b2cAPIServer) doDaraja(endpoint string, req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	res, err := b2cAPI.HTTPClient.Do(req)
+	metrics.ObserveDarajaRequest(endpoint, start, res)
+	return res, err
+}
+
 func (b2cAPI *b2cAPIServer) ListDailyStats(
 	ctx context.Context, req *b2c.ListDailyStatsRequest,
 ) (*b2c.StatsResponse, error) {
@@ -862,8 +1438,12 @@ func (b2cAPI *b2cAPIServer) ListDailyStats(
 	}
 
 	// Apply filters
+	db, err = scopeStats(ctx, db)
+	if err != nil {
+		return nil, err
+	}
 	if len(orgShortCodes) > 0 {
-		db = db.Where("short_code IN(?)", orgShortCodes)
+		db = db.Where("org_short_code IN(?)", orgShortCodes)
 	}
 	if req.GetFilter().GetStartTimeSeconds() < req.GetFilter().GetEndTimeSeconds() {
 		db = db.Where("created_at BETWEEN ? AND ?", req.GetFilter().GetStartTimeSeconds(), req.GetFilter().GetEndTimeSeconds())
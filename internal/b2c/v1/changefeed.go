@@ -0,0 +1,478 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	"github.com/gidyon/mpesa-b2c/internal/rbac"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	changeFeedStream        = "b2cchanges"
+	changeFeedField         = "payment"
+	defaultChangeFeedMaxLen = 100000
+	changeFeedReadCount     = 100
+	changeFeedBlock         = 5 * time.Second
+	// changeFeedRecentSize is how many changes are held in memory for watchers
+	changeFeedRecentSize = 1000
+)
+
+// ChangeFeed is a cluster wide feed of b2c payment changes stored on a redis stream.
+//
+// A single reader per replica tails the stream and fans changes out to watchers in memory so that watchers
+// do not each hold a redis connection in a blocking read.
+type ChangeFeed struct {
+	redisDB *redis.Client
+	maxLen  int64
+	logger  grpclog.LoggerV2
+
+	mu sync.Mutex
+	// tailing is whether the reader is running
+	tailing bool
+	// position is the id the reader has read up to
+	position string
+	// recentAfter is the id that recent changes follow
+	recentAfter string
+	recent      []*PaymentChange
+	// notify is closed when the reader receives changes
+	notify   chan struct{}
+	lastRead time.Time
+}
+
+// NewChangeFeed creates a change feed retaining approximately maxLen changes
+func NewChangeFeed(redisDB *redis.Client, maxLen int64, logger grpclog.LoggerV2) *ChangeFeed {
+	if maxLen <= 0 {
+		maxLen = defaultChangeFeedMaxLen
+	}
+	return &ChangeFeed{
+		redisDB: redisDB,
+		maxLen:  maxLen,
+		logger:  logger,
+		notify:  make(chan struct{}),
+	}
+}
+
+// Append adds the payment to the feed returning the id of the change
+func (feed *ChangeFeed) Append(ctx context.Context, pb *b2c.B2CPayment) (string, error) {
+	bs, err := proto.Marshal(pb)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal payment: %v", err)
+	}
+
+	// The payment carries the msisdn and customer names
+	bs, err = pii.Seal(bs)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt payment: %v", err)
+	}
+
+	return feed.redisDB.XAdd(ctx, &redis.XAddArgs{
+		Stream: changeFeedStream,
+		MaxLen: feed.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{changeFeedField: bs},
+	}).Result()
+}
+
+// PaymentChange is a payment read from the change feed
+type PaymentChange struct {
+	ID      string
+	Payment *b2c.B2CPayment
+}
+
+// LastID returns the id of the latest change or 0-0 when the feed is empty
+func (feed *ChangeFeed) LastID(ctx context.Context) (string, error) {
+	msgs, err := feed.redisDB.XRevRangeN(ctx, changeFeedStream, "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return "0-0", nil
+	}
+	return msgs[0].ID, nil
+}
+
+// Read returns changes after the id, blocking until changes arrive or the block duration elapses.
+//
+// It also returns the id to read from next. Recent changes are served from memory; watchers that are
+// further behind catch up from the stream without blocking. Changes that cannot be decoded are logged
+// and skipped so that they do not stall readers.
+func (feed *ChangeFeed) Read(ctx context.Context, afterID string) ([]*PaymentChange, string, error) {
+	timer := time.NewTimer(changeFeedBlock)
+	defer timer.Stop()
+
+	for {
+		changes, nextID, notify, ok := feed.recentChanges(afterID)
+		if !ok {
+			var err error
+			changes, nextID, err = feed.readStream(ctx, afterID)
+			if err != nil {
+				return nil, afterID, err
+			}
+		}
+		if len(changes) > 0 || nextID != afterID {
+			return changes, nextID, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, afterID, ctx.Err()
+		case <-timer.C:
+			return []*PaymentChange{}, afterID, nil
+		case <-notify:
+		}
+	}
+}
+
+// recentChanges returns copies of the changes after the id that are held in memory, starting the reader
+// when it is not running. It reports false when the id is outside the changes held.
+func (feed *ChangeFeed) recentChanges(afterID string) ([]*PaymentChange, string, <-chan struct{}, bool) {
+	feed.mu.Lock()
+	defer feed.mu.Unlock()
+
+	feed.lastRead = time.Now()
+	if !feed.tailing {
+		feed.tailing = true
+		go feed.tail()
+	}
+
+	if feed.position == "" || compareStreamIDs(afterID, feed.recentAfter) < 0 || compareStreamIDs(afterID, feed.position) > 0 {
+		return nil, afterID, feed.notify, false
+	}
+
+	nextID := afterID
+	changes := make([]*PaymentChange, 0)
+	for _, change := range feed.recent {
+		if len(changes) == changeFeedReadCount {
+			return changes, nextID, feed.notify, true
+		}
+		if compareStreamIDs(change.ID, afterID) > 0 {
+			// Watchers mask the payments they receive
+			changes = append(changes, &PaymentChange{ID: change.ID, Payment: proto.Clone(change.Payment).(*b2c.B2CPayment)})
+			nextID = change.ID
+		}
+	}
+
+	// Changes that failed to decode are skipped too
+	if compareStreamIDs(feed.position, nextID) > 0 {
+		nextID = feed.position
+	}
+
+	return changes, nextID, feed.notify, true
+}
+
+// tail reads the stream into memory until no watcher has read for a while
+func (feed *ChangeFeed) tail() {
+	ctx := context.Background()
+
+	// Watchers read from the stream until a later read starts the reader again
+	position, err := feed.LastID(ctx)
+	feed.mu.Lock()
+	if err != nil {
+		feed.logger.Errorf("change feed reader failed to get feed position: %v", err)
+		feed.tailing = false
+		feed.mu.Unlock()
+		return
+	}
+	feed.position = position
+	feed.recentAfter = position
+	feed.recent = nil
+	feed.mu.Unlock()
+
+	for {
+		feed.mu.Lock()
+		if time.Since(feed.lastRead) > 2*changeFeedBlock {
+			feed.tailing = false
+			feed.position = ""
+			feed.recent = nil
+			feed.mu.Unlock()
+			return
+		}
+		feed.mu.Unlock()
+
+		changes, nextID, err := feed.read(ctx, position, changeFeedBlock)
+		if err != nil {
+			feed.logger.Errorf("change feed reader failed to read feed: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+		if nextID == position {
+			continue
+		}
+		position = nextID
+
+		feed.mu.Lock()
+		feed.position = position
+		feed.recent = append(feed.recent, changes...)
+		if n := len(feed.recent) - changeFeedRecentSize; n > 0 {
+			feed.recentAfter = feed.recent[n-1].ID
+			feed.recent = append([]*PaymentChange(nil), feed.recent[n:]...)
+		}
+		close(feed.notify)
+		feed.notify = make(chan struct{})
+		feed.mu.Unlock()
+	}
+}
+
+// readStream reads changes after the id from the stream without blocking
+func (feed *ChangeFeed) readStream(ctx context.Context, afterID string) ([]*PaymentChange, string, error) {
+	return feed.read(ctx, afterID, -1)
+}
+
+// read reads changes after the id from the stream; a negative block does not block
+func (feed *ChangeFeed) read(ctx context.Context, afterID string, block time.Duration) ([]*PaymentChange, string, error) {
+	res, err := feed.redisDB.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{changeFeedStream, afterID},
+		Count:   changeFeedReadCount,
+		Block:   block,
+	}).Result()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return []*PaymentChange{}, afterID, nil
+	default:
+		return nil, afterID, err
+	}
+
+	lastID := afterID
+	changes := make([]*PaymentChange, 0, changeFeedReadCount)
+	for _, stream := range res {
+		for _, msg := range stream.Messages {
+			lastID = msg.ID
+
+			bs, err := pii.Open([]byte(fmt.Sprint(msg.Values[changeFeedField])))
+			if err != nil {
+				feed.logger.Errorf("skipping change %s that failed to decrypt: %v", msg.ID, err)
+				continue
+			}
+			pb := &b2c.B2CPayment{}
+			err = proto.Unmarshal(bs, pb)
+			if err != nil {
+				feed.logger.Errorf("skipping change %s that failed to unmarshal: %v", msg.ID, err)
+				continue
+			}
+			changes = append(changes, &PaymentChange{ID: msg.ID, Payment: pb})
+		}
+	}
+
+	return changes, lastID, nil
+}
+
+// compareStreamIDs compares two redis stream ids
+func compareStreamIDs(a, b string) int {
+	aMs, aSeq := splitStreamID(a)
+	bMs, bSeq := splitStreamID(b)
+	switch {
+	case aMs < bMs, aMs == bMs && aSeq < bSeq:
+		return -1
+	case aMs == bMs && aSeq == bSeq:
+		return 0
+	default:
+		return 1
+	}
+}
+
+func splitStreamID(id string) (uint64, uint64) {
+	ms, seq, _ := strings.Cut(id, "-")
+	msVal, _ := strconv.ParseUint(ms, 10, 64)
+	seqVal, _ := strconv.ParseUint(seq, 10, 64)
+	return msVal, seqVal
+}
+
+func containsString(vals []string, v string) bool {
+	for _, val := range vals {
+		if val == v {
+			return true
+		}
+	}
+	return false
+}
+
+// PaymentMatchesFilter checks in memory whether the payment satisfies the list filter
+func PaymentMatchesFilter(filter *b2c.ListB2CPaymentFilter, pb *b2c.B2CPayment) (bool, error) {
+	if filter == nil {
+		return true, nil
+	}
+
+	switch {
+	case filter.StartTimestamp < filter.EndTimestamp:
+		if pb.TransactionTimestamp < filter.StartTimestamp || pb.TransactionTimestamp > filter.EndTimestamp {
+			return false, nil
+		}
+	case filter.TxDate != "":
+		t, err := getTime(filter.TxDate)
+		if err != nil {
+			return false, err
+		}
+		if pb.TransactionTimestamp < t.Unix() || pb.TransactionTimestamp > t.Add(24*time.Hour).Unix() {
+			return false, nil
+		}
+	}
+
+	switch {
+	case len(filter.Msisdns) > 0 && !containsString(filter.Msisdns, pb.Msisdn):
+		return false, nil
+	case len(filter.MpesaReceipts) > 0 && !containsString(filter.MpesaReceipts, pb.MpesaReceiptId):
+		return false, nil
+	case len(filter.InitiatorIds) > 0 && !containsString(filter.InitiatorIds, pb.InitiatorId):
+		return false, nil
+	case len(filter.InitiatorCustomerReferences) > 0 && !containsString(filter.InitiatorCustomerReferences, pb.InitiatorCustomerReference):
+		return false, nil
+	case len(filter.InitiatorTransactionReferences) > 0 && !containsString(filter.InitiatorTransactionReferences, pb.InitiatorTransactionReference):
+		return false, nil
+	case len(filter.ShortCodes) > 0 && !containsString(filter.ShortCodes, pb.OrgShortCode):
+		return false, nil
+	case filter.AmountMin > 0 && pb.Amount < filter.AmountMin:
+		return false, nil
+	case filter.AmountMax > 0 && pb.Amount > filter.AmountMax:
+		return false, nil
+	case filter.InitiatorCustomerNames != "" &&
+		!strings.Contains(strings.ToLower(pb.InitiatorCustomerNames), strings.ToLower(filter.InitiatorCustomerNames)):
+		return false, nil
+	case filter.ReceiverPartyPublicName != "" &&
+		!strings.Contains(strings.ToLower(pb.ReceiverPartyPublicName), strings.ToLower(filter.ReceiverPartyPublicName)):
+		return false, nil
+	case filter.SucceededState == b2c.B2CSucceededState_B2C_SUCCEEDED && !pb.Succeeded:
+		return false, nil
+	case filter.SucceededState == b2c.B2CSucceededState_B2C_NOT_SUCCEEDED && pb.Succeeded:
+		return false, nil
+	case filter.RecipientState == b2c.B2CRecipientState_B2C_RECIPIENT_REGISTERED && !pb.RecipientRegistered:
+		return false, nil
+	case filter.RecipientState == b2c.B2CRecipientState_B2C_RECIPIENT_NOT_REGISTERED && pb.RecipientRegistered:
+		return false, nil
+	}
+
+	if len(filter.CommandIds) > 0 {
+		found := false
+		for _, commandID := range filter.CommandIds {
+			if commandID == pb.CommandId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	if len(filter.B2CStatuses) > 0 {
+		found := false
+		for _, status := range filter.B2CStatuses {
+			if status == pb.B2CStatus {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+
+	switch filter.ProcessState {
+	case b2c.B2CProcessedState_B2C_NOT_PROCESSED:
+		return !pb.Processed, nil
+	case b2c.B2CProcessedState_B2C_PROCESSED:
+		return pb.Processed, nil
+	}
+
+	return true, nil
+}
+
+// ValidResumeToken checks that the token is a change feed id
+func ValidResumeToken(token string) bool {
+	parts := strings.Split(token, "-")
+	if len(parts) != 2 {
+		return false
+	}
+	for _, part := range parts {
+		if part == "" || strings.Trim(part, "0123456789") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (b2cAPI *b2cAPIServer) WatchB2CPayments(
+	req *b2c.WatchB2CPaymentsRequest, stream b2c.B2CV1_WatchB2CPaymentsServer,
+) error {
+	ctx := stream.Context()
+
+	// Authorization
+	payload, err := b2cAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return errs.MissingField("watch request")
+	case req.ResumeToken != "" && !ValidResumeToken(req.ResumeToken):
+		return errs.IncorrectVal("resume token")
+	}
+
+	// Validate the filter before streaming
+	_, err = PaymentMatchesFilter(req.Filter, &b2c.B2CPayment{})
+	if err != nil {
+		return err
+	}
+
+	access := b2cAPI.PIIPolicy.Access(payload)
+	scope := rbac.FromContext(ctx)
+
+	lastID := req.ResumeToken
+	if lastID == "" {
+		lastID, err = b2cAPI.ChangeFeed.LastID(ctx)
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
+			return errs.WrapMessage(codes.Internal, "failed to get change feed position")
+		}
+	}
+
+	for {
+		changes, nextID, err := b2cAPI.ChangeFeed.Read(ctx, lastID)
+		switch {
+		case err == nil:
+		case ctx.Err() != nil:
+			return nil
+		default:
+			b2cAPI.Logger.Errorln(err)
+			return errs.WrapMessage(codes.Internal, "failed to read change feed")
+		}
+		lastID = nextID
+
+		for _, change := range changes {
+			ok, _ := PaymentMatchesFilter(req.Filter, change.Payment)
+			if !ok || !scope.Allows(change.Payment.OrgShortCode, change.Payment.InitiatorId) {
+				continue
+			}
+
+			access.MaskPayment(change.Payment)
+
+			err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "WatchB2CPayments", "", change.Payment.TransactionId)
+			if err != nil {
+				b2cAPI.Logger.Errorln(err)
+				return errs.WrapMessage(codes.Internal, "failed to watch b2c payments")
+			}
+
+			err = stream.Send(&b2c.WatchB2CPaymentsResponse{
+				ResumeToken: change.ID,
+				Payment:     change.Payment,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
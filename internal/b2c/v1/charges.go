@@ -0,0 +1,40 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MpesaCharge works out the charge of a payment from the balance of the charges paid account after it.
+//
+// Mpesa does not report the charge of a payment, only the balance of the account charges are paid from, so the
+// charge is the drop in balance since the payment completed before it on the short code. Balances that rise
+// through top ups or have no earlier payment give no charge.
+func MpesaCharge(
+	ctx context.Context, sqlDB *gorm.DB, shortCode string, completedAt time.Time, chargesPaidFunds float32,
+) (float32, error) {
+	prev := &Payment{}
+
+	err := sqlDB.WithContext(ctx).Select("charges_paid_account_funds").
+		Where("org_short_code = ? AND succeeded = ? AND transaction_time < ?", shortCode, "YES", completedAt).
+		Where("charges_paid_account_funds <> 0").
+		Order("transaction_time DESC").
+		First(prev).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("failed to get previous charges paid account balance: %v", err)
+	}
+
+	if charge := prev.ChargesPaidAccountFunds - chargesPaidFunds; charge > 0 {
+		return charge, nil
+	}
+
+	return 0, nil
+}
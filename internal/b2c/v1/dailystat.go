@@ -2,66 +2,54 @@ package b2c_app_v1
 
 import (
 	"context"
-	"database/sql"
 	"errors"
-	"fmt"
+	"math"
 	"time"
 
-	"github.com/gidyon/mpesapayments/pkg/utils/timeutil"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
 	"gorm.io/gorm"
 )
 
+// dailyStatInterval is how often the leader regenerates statistics of the current day
+const dailyStatInterval = 30 * time.Minute
+
 func (b2cAPI *b2cAPIServer) dailyDailyStatWorker(ctx context.Context) {
-	ticker := time.NewTicker(2*time.Hour + time.Minute)
+	ticker := time.NewTicker(dailyStatInterval)
 	defer ticker.Stop()
 
-loop:
+	b2cAPI.heartbeat(workerDailyStats, dailyStatInterval)
+
 	for {
-		currTime := time.Now()
 		select {
 		case <-ctx.Done():
+			return
 		case <-ticker.C:
-			currTime2 := time.Now()
+			b2cAPI.heartbeat(workerDailyStats, dailyStatInterval)
 
-			lockKey := fmt.Sprintf("workerlock:%s", currTime2.UTC().String()[:15])
-			existRes, err := b2cAPI.RedisDB.Exists(ctx, lockKey).Result()
-			if err != nil {
-				b2cAPI.Logger.Errorf("failed to check if key exists: %v", err)
+			runCtx, ok := b2cAPI.leaderRun(ctx, workerDailyStats)
+			if !ok {
 				continue
 			}
 
-			if existRes == 0 {
-				// Key does not exist so we set it
-				err = b2cAPI.RedisDB.Set(ctx, lockKey, "yes", 2*time.Hour).Err()
-				if err != nil {
-					b2cAPI.Logger.Errorf("failed to set lock key: %v", err)
-					continue
-				}
-			} else if existRes == 1 {
-				// Key does exist so we dont generate the statistics
-				continue
-			}
+			today := timeutil.DayStart(time.Now())
+			days := []time.Time{today}
 
-			if currTime2.Day() != currTime.Day() {
-				// we're in the next day; lets update current statistics for yesterday first
-				startTime, err := timeutil.ParseDayStartTime(int32(currTime.Year()), int32(currTime.Month()), int32(currTime.Day()))
-				if err != nil {
-					b2cAPI.Logger.Errorf("WORKER: failed to parse start timestamp: %v", err)
-					goto loop
-				}
-				endTime := startTime.Add(time.Hour * 24)
-
-				b2cAPI.generateDailyStatistics(ctx, startTime, &endTime)
-			} else {
-				endTime, err := timeutil.ParseDayEndTime(int32(currTime2.Year()), int32(currTime2.Month()), int32(currTime2.Day()))
-				if err != nil {
-					b2cAPI.Logger.Errorf("WORKER: failed to parse end timestamp: %v", err)
-					goto loop
-				}
+			// Early runs of a day finalise yesterday since results of its last transfers arrive after midnight
+			if time.Since(today) < 2*dailyStatInterval {
+				days = append([]time.Time{today.AddDate(0, 0, -1)}, days...)
+			}
 
-				startTime := time.Unix(endTime.Unix()-int64(24*60*60), 0)
+			for _, startTime := range days {
+				startTime := startTime
+				endTime := startTime.AddDate(0, 0, 1)
 
-				b2cAPI.generateDailyStatistics(ctx, &startTime, &endTime)
+				err := b2cAPI.generateDailyStatistics(runCtx, &startTime, &endTime)
+				if err == nil {
+					err = b2cAPI.generateMonthlyStatistics(runCtx, startTime)
+				}
+				metrics.ObserveStatsWorkerRun(metrics.WorkerDailyStats, err)
 			}
 		}
 	}
@@ -71,18 +59,23 @@ type shortCode struct {
 	OrgShortCode string
 }
 
-func (b2cAPI *b2cAPIServer) generateDailyStatistics(ctx context.Context, startTime, endTime *time.Time) {
+func (b2cAPI *b2cAPIServer) generateDailyStatistics(
+	ctx context.Context, startTime, endTime *time.Time, orgShortCodes ...string,
+) error {
 
 	// Get all unique org_short_code
 	shortCodes := make([]*shortCode, 0)
-	err := b2cAPI.SQLDB.Table((&Payment{}).TableName()).
-		Where("transaction_time BETWEEN ? AND ?", startTime, endTime).
-		Distinct("org_short_code").
+	db := b2cAPI.SQLDB.Table((&Payment{}).TableName()).
+		Where("transaction_time BETWEEN ? AND ?", startTime, endTime)
+	if len(orgShortCodes) > 0 {
+		db = db.Where("org_short_code IN(?)", orgShortCodes)
+	}
+	err := db.Distinct("org_short_code").
 		Select("org_short_code").
 		Scan(&shortCodes).Error
 	if err != nil {
 		b2cAPI.Logger.Errorf("WORKER: failed to scan org_short_code to slice: %v", err)
-		return
+		return err
 	}
 
 	// Generate report
@@ -94,100 +87,188 @@ func (b2cAPI *b2cAPIServer) generateDailyStatistics(ctx context.Context, startTi
 
 		date := startTime.String()[:10]
 
-		var transactions int64
+		totals := &dailyTotals{}
 
-		// Count of total transactions
-		err = db.Count(&transactions).Error
+		err = db.Session(&gorm.Session{}).Select(
+			"COUNT(*) AS transactions, " +
+				"SUM(CASE WHEN succeeded = 'YES' THEN 1 ELSE 0 END) AS successful_transactions, " +
+				"SUM(CASE WHEN b2c_status = '" + b2c.B2CStatus_B2C_REQUEST_SUBMITED.String() + "' THEN 1 ELSE 0 END) AS pending_transactions, " +
+				"SUM(CASE WHEN b2c_status IN ('', '" + b2c.B2CStatus_B2C_STATUS_UNKNOWN.String() + "') THEN 1 ELSE 0 END) AS unknown_transactions, " +
+				"COUNT(DISTINCT " + msisdnColumn() + ") AS unique_recipients, " +
+				"COALESCE(SUM(transaction_amount), 0) AS total_amount, " +
+				"COALESCE(SUM(system_charges), 0) AS total_charges, " +
+				"COALESCE(SUM(mpesa_charges), 0) AS total_mpesa_charges",
+		).Scan(totals).Error
 		if err != nil {
 			b2cAPI.Logger.Errorf(
-				"WORKER: failed to count transactions for day %s org_short_code %s: %v",
+				"WORKER: failed to compute totals for day [%s] org_short_code [%s]: %v",
 				date, shortCode.OrgShortCode, err,
 			)
-			return
+			return err
 		}
 
-		var successfulTransactions int64
-
-		// Count of successful transactions
-		err = db.Where("succeeded = ?", true).Count(&successfulTransactions).Error
+		commandStats, err := commandStatistics(db.Session(&gorm.Session{}))
 		if err != nil {
 			b2cAPI.Logger.Errorf(
-				"WORKER: failed to count successful transactions for day [%s] org_short_code [%s]: %v",
+				"WORKER: failed to compute command stats for day [%s] org_short_code [%s]: %v",
 				date, shortCode.OrgShortCode, err,
 			)
-			return
+			return err
 		}
 
-		var totalAmount sql.NullFloat64
-
-		// Get total amount transacted
-		err = db.Model(&Payment{}).Select("sum(transaction_amount) as total").Row().Scan(&totalAmount)
+		commandStatsBs, err := marshalCommandStats(commandStats)
 		if err != nil {
-			b2cAPI.Logger.Errorf(
-				"WORKER: failed to get sum of transactions for day [%]s org_short_code [%s]: %v",
-				date, shortCode.OrgShortCode, err,
-			)
-			return
+			b2cAPI.Logger.Errorf("WORKER: failed to marshal command stats: %v", err)
+			return err
 		}
 
-		var totalCharges sql.NullFloat64
-
-		// Get total charges
-		err = db.Model(&Payment{}).Select("sum(system_charges) as total").Row().Scan(&totalCharges)
+		avgLatency, p95Latency, err := callbackLatencies(db.Session(&gorm.Session{}))
 		if err != nil {
 			b2cAPI.Logger.Errorf(
-				"WORKER: failed to get sum of transactions for day %s org_short_code %s: %v",
+				"WORKER: failed to compute callback latency for day [%s] org_short_code [%s]: %v",
 				date, shortCode.OrgShortCode, err,
 			)
-			return
-		}
-
-		var totalAmountF, totalChargesF float32
-
-		if totalAmount.Valid {
-			totalAmountF = float32(totalAmount.Float64)
-		}
-		if totalCharges.Valid {
-			totalChargesF = float32(totalCharges.Float64)
+			return err
 		}
 
 		// Create stat
 		statDB := &DailyStat{
 			OrgShortCode:           shortCode.OrgShortCode,
 			Date:                   date,
-			TotalTransactions:      int32(transactions),
-			SuccessfulTransactions: int32(successfulTransactions),
-			FailedTransactions:     int32(transactions) - int32(successfulTransactions),
-			TotalAmountTransacted:  totalAmountF,
-			TotalCharges:           totalChargesF,
+			TotalTransactions:      int32(totals.Transactions),
+			SuccessfulTransactions: int32(totals.SuccessfulTransactions),
+			FailedTransactions: int32(
+				totals.Transactions - totals.SuccessfulTransactions - totals.PendingTransactions - totals.UnknownTransactions,
+			),
+			TotalAmountTransacted: float32(totals.TotalAmount),
+			TotalCharges:          float32(totals.TotalCharges),
+			TotalMpesaCharges:     float32(totals.TotalMpesaCharges),
+			UniqueRecipients:      totals.UniqueRecipients,
+			PendingTransactions:   totals.PendingTransactions,
+			UnknownTransactions:   totals.UnknownTransactions,
+			CommandStats:          string(commandStatsBs),
+			AverageLatencyMillis:  avgLatency,
+			P95LatencyMillis:      p95Latency,
 		}
 
-		statDB2 := &DailyStat{}
-
 		// Save statistics
-		err = b2cAPI.SQLDB.First(statDB2, "org_short_code = ? AND date = ?", shortCode.OrgShortCode, date).Error
-		switch {
-		case err == nil:
-			// Update
-			err = b2cAPI.SQLDB.Where("id = ?", statDB2.ID).Updates(statDB).Error
-			if err != nil {
-				b2cAPI.Logger.Errorf(
-					"WORKER: failed to update stats for day [%s] org_short_code [%s] : %v", date, shortCode.OrgShortCode, err,
-				)
-				return
+		err = b2cAPI.fencedTransaction(ctx, func(tx *gorm.DB) error {
+			statDB2 := &DailyStat{}
+			err := tx.First(statDB2, "org_short_code = ? AND date = ?", shortCode.OrgShortCode, date).Error
+			switch {
+			case err == nil:
+				return tx.Model(statDB2).Select("*").Omit("id", "created_at", "deleted_at").Updates(statDB).Error
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return tx.Create(statDB).Error
+			default:
+				return err
 			}
-		case errors.Is(err, gorm.ErrRecordNotFound):
-			// Create
-			err = b2cAPI.SQLDB.Create(statDB).Error
-			if err != nil {
-				b2cAPI.Logger.Errorf(
-					"WORKER: failed to create stats for day [%s] org_short_code [%s] : %v", date, shortCode.OrgShortCode, err,
-				)
-				return
-			}
-		default:
-			b2cAPI.Logger.Errorf("WORKER: failed to find stat %v", err)
-			return
+		})
+		if err != nil {
+			b2cAPI.Logger.Errorf(
+				"WORKER: failed to save stats for day [%s] org_short_code [%s] : %v", date, shortCode.OrgShortCode, err,
+			)
+			return err
 		}
 	}
+	return nil
+}
+
+type dailyTotals struct {
+	Transactions           int64
+	SuccessfulTransactions int64
+	PendingTransactions    int64
+	UnknownTransactions    int64
+	UniqueRecipients       int64
+	TotalAmount            float64
+	TotalCharges           float64
+	TotalMpesaCharges      float64
+}
+
+type commandTotals struct {
+	CommandID              string
+	TotalTransactions      int64
+	SuccessfulTransactions int64
+	TotalAmount            float64
+}
+
+// commandStatistics breaks down the payments in the query by command id
+func commandStatistics(db *gorm.DB) ([]*b2c.CommandIdStat, error) {
+	totals := make([]*commandTotals, 0)
+
+	err := db.Select(
+		"command_id, COUNT(*) AS total_transactions, " +
+			"SUM(CASE WHEN succeeded = 'YES' THEN 1 ELSE 0 END) AS successful_transactions, " +
+			"COALESCE(SUM(transaction_amount), 0) AS total_amount",
+	).Group("command_id").Scan(&totals).Error
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*b2c.CommandIdStat, 0, len(totals))
+	for _, total := range totals {
+		stats = append(stats, &b2c.CommandIdStat{
+			CommandId:              b2c.CommandId(b2c.CommandId_value[total.CommandID]),
+			TotalTransactions:      total.TotalTransactions,
+			SuccessfulTransactions: total.SuccessfulTransactions,
+			TotalAmountTransacted:  float32(total.TotalAmount),
+		})
+	}
+
+	return stats, nil
+}
+
+// callbackLatencies returns the average and 95th percentile time in milliseconds from submission to callback.
+//
+// Only payments whose result was received are considered since pending payments have no completion time.
+// Both are worked out by the database so that payments of busy short codes are not loaded into memory.
+func callbackLatencies(db *gorm.DB) (int64, int64, error) {
+	db = db.Where("b2c_status IN (?)", []string{b2c.B2CStatus_B2C_SUCCESS.String(), b2c.B2CStatus_B2C_FAILED.String()}).
+		Where("transaction_time IS NOT NULL").
+		Session(&gorm.Session{})
+
+	latency := latencyMillisExpr(db.Dialector.Name())
+
+	totals := &latencyTotals{}
+	err := db.Select("COUNT(*) AS count, COALESCE(AVG(" + latency + "), 0) AS average").Scan(totals).Error
+	if err != nil {
+		return 0, 0, err
+	}
+	if totals.Count == 0 {
+		return 0, 0, nil
+	}
+
+	// The 95th percentile is the latency at its rank among the ordered latencies
+	rank := (totals.Count*95+99)/100 - 1
+	p95 := &latencyPercentile{}
+	err = db.Select(latency + " AS latency").Order("latency").Offset(int(rank)).Limit(1).Scan(p95).Error
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return int64(math.Round(totals.Average)), int64(math.Round(p95.Latency)), nil
+}
+
+type latencyTotals struct {
+	Count   int64
+	Average float64
+}
+
+type latencyPercentile struct {
+	Latency float64
+}
+
+// latencyMillisExpr is the time in milliseconds from submission to callback of a payment
+func latencyMillisExpr(dialect string) string {
+	var expr string
+	switch dialect {
+	case "postgres":
+		expr = "EXTRACT(EPOCH FROM (transaction_time - created_at)) * 1000"
+	case "sqlite":
+		expr = "(julianday(transaction_time) - julianday(created_at)) * 86400000"
+	default:
+		expr = "TIMESTAMPDIFF(MICROSECOND, created_at, transaction_time) / 1000"
+	}
+	// Completion times reported before submission are clock skew
+	return "CASE WHEN " + expr + " < 0 THEN 0 ELSE " + expr + " END"
 }
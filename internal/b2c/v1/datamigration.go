@@ -0,0 +1,135 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const (
+	dataMigrationsTable = "b2c_data_migrations"
+
+	dataMigrationBatchSize = 500
+	dataMigrationLockTTL   = 30 * time.Second
+
+	// transaction times were parsed from mpesa timestamps as UTC instead of the reporting timezone
+	transactionTimeMigration = "transaction_time_reporting_timezone"
+)
+
+// DataMigration tracks the progress of a one-off data migration
+type DataMigration struct {
+	Name      string `gorm:"primaryKey;type:varchar(100)"`
+	LastID    uint   `gorm:"not null"`
+	Done      bool
+	CreatedAt time.Time `gorm:"autoCreateTime;precision:6;not null"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;precision:6"`
+}
+
+// TableName is table name for model
+func (*DataMigration) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), dataMigrationsTable)
+	}
+	return dataMigrationsTable
+}
+
+// migrateTransactionTimes corrects transaction times of completed payments that were stored
+// with the mpesa wall clock time read as UTC.
+//
+// Progress is saved after each batch so an interrupted migration resumes without shifting rows twice.
+func (b2cAPI *b2cAPIServer) migrateTransactionTimes(ctx context.Context) error {
+	// Nothing to correct when reporting in UTC
+	if _, offset := time.Now().In(timeutil.ReportingLocation()).Zone(); offset == 0 {
+		return nil
+	}
+
+	// Only one instance runs the migration
+	lock, ctx, err := acquireRedisLock(ctx, b2cAPI.RedisDB, "migrationlock:"+transactionTimeMigration, dataMigrationLockTTL)
+	switch {
+	case err == nil:
+		defer lock.release()
+	case errors.Is(err, errLockHeld):
+		return nil
+	default:
+		return err
+	}
+
+	sqlDB := b2cAPI.SQLDB
+
+	migrationDB := &DataMigration{}
+
+	err = sqlDB.WithContext(ctx).First(migrationDB, "name = ?", transactionTimeMigration).Error
+	switch {
+	case err == nil:
+		if migrationDB.Done {
+			return nil
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		migrationDB = &DataMigration{Name: transactionTimeMigration}
+		err = sqlDB.WithContext(ctx).Create(migrationDB).Error
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	// Payments updated from now on have their times parsed correctly
+	startedAt := time.Now()
+	migrated := 0
+
+	for {
+		payments := make([]*Payment, 0, dataMigrationBatchSize)
+
+		err = sqlDB.WithContext(ctx).
+			Select("id", "transaction_time").
+			Where("id > ?", migrationDB.LastID).
+			Where("succeeded = ? AND mpesa_receipt_id IS NOT NULL AND transaction_time IS NOT NULL", "YES").
+			Where("updated_at < ?", startedAt).
+			Order("id").
+			Limit(dataMigrationBatchSize).
+			Find(&payments).Error
+		if err != nil {
+			return err
+		}
+
+		if len(payments) == 0 {
+			break
+		}
+
+		err = sqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, paymentDB := range payments {
+				err := tx.Model(paymentDB).
+					UpdateColumn("transaction_time", timeutil.InReportingLocation(paymentDB.TransactionTime.Time)).Error
+				if err != nil {
+					return err
+				}
+			}
+			return tx.Model(migrationDB).Update("last_id", payments[len(payments)-1].ID).Error
+		})
+		if err != nil {
+			return err
+		}
+
+		migrated += len(payments)
+	}
+
+	err = sqlDB.WithContext(ctx).Model(migrationDB).Update("done", true).Error
+	if err != nil {
+		return err
+	}
+
+	if migrated > 0 {
+		b2cAPI.Logger.Infof(
+			"corrected transaction times of %d payments to %s; regenerate statistics for the affected dates",
+			migrated, timeutil.ReportingLocation(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gidyon/mpesa-b2c/internal/pii"
+	"gorm.io/gorm"
+)
+
+// encryptedColumns are the personal data columns of a payment as stored
+type encryptedColumns struct {
+	ID                     uint
+	Msisdn                 string
+	MsisdnIndex            string
+	InitiatorCustomerNames string
+	ReceiverPublicName     string
+}
+
+// encryptedColumnsSelect selects encryptedColumns of payments
+const encryptedColumnsSelect = "id, COALESCE(msisdn, '') AS msisdn, COALESCE(msisdn_index, '') AS msisdn_index, " +
+	"COALESCE(initiator_customer_names, '') AS initiator_customer_names, " +
+	"COALESCE(receiver_public_name, '') AS receiver_public_name"
+
+// reencryptAttempts is how many times a payment is re-read when it changes while being re-encrypted
+const reencryptAttempts = 5
+
+// ReencryptPayments rewrites personal data of payments and archived payments that is in plaintext or encrypted
+// under a key other than the primary key, filling in missing msisdn blind indexes.
+//
+// It returns the number of payments rewritten. Payments archived to files keep the key they were written with.
+func ReencryptPayments(ctx context.Context, sqlDB *gorm.DB, batchSize int) (int64, error) {
+	kr := pii.Current()
+	if kr == nil {
+		return 0, errors.New("no keyring is configured")
+	}
+	if batchSize <= 0 {
+		batchSize = dataMigrationBatchSize
+	}
+
+	var total int64
+
+	for _, model := range []tabler{&Payment{}, &ArchivedPayment{}} {
+		var lastID uint
+
+		for {
+			rows := make([]*encryptedColumns, 0, batchSize)
+
+			err := sqlDB.WithContext(ctx).Table(model.TableName()).
+				Select(encryptedColumnsSelect).
+				Where("id > ?", lastID).
+				Order("id").
+				Limit(batchSize).
+				Scan(&rows).Error
+			if err != nil {
+				return total, fmt.Errorf("failed to get payments from %s: %v", model.TableName(), err)
+			}
+			if len(rows) == 0 {
+				break
+			}
+
+			for _, row := range rows {
+				lastID = row.ID
+
+				updated, err := reencryptPayment(ctx, sqlDB, kr, model.TableName(), row)
+				if err != nil {
+					return total, err
+				}
+				if updated {
+					total++
+				}
+			}
+		}
+	}
+
+	return total, nil
+}
+
+// reencryptPayment rewrites the personal data of a payment only while it is still as read.
+//
+// A payment changed by a concurrent write, e.g. a callback setting the receiver name, is read again so the write is not lost.
+func reencryptPayment(ctx context.Context, sqlDB *gorm.DB, kr *pii.Keyring, table string, row *encryptedColumns) (bool, error) {
+	for attempt := 0; attempt < reencryptAttempts; attempt++ {
+		if attempt > 0 {
+			rows := make([]*encryptedColumns, 0, 1)
+			err := sqlDB.WithContext(ctx).Table(table).Select(encryptedColumnsSelect).Where("id = ?", row.ID).Limit(1).Scan(&rows).Error
+			if err != nil {
+				return false, fmt.Errorf("failed to get payment %d from %s: %v", row.ID, table, err)
+			}
+			// Archived or deleted in the meantime
+			if len(rows) == 0 {
+				return false, nil
+			}
+			row = rows[0]
+		}
+
+		updates, err := reencryptColumns(kr, row)
+		if err != nil {
+			return false, fmt.Errorf("failed to re-encrypt payment %d in %s: %v", row.ID, table, err)
+		}
+		if updates == nil {
+			return false, nil
+		}
+
+		res := sqlDB.WithContext(ctx).Table(table).
+			Where(
+				"id = ? AND COALESCE(msisdn, '') = ? AND COALESCE(msisdn_index, '') = ? AND "+
+					"COALESCE(initiator_customer_names, '') = ? AND COALESCE(receiver_public_name, '') = ?",
+				row.ID, row.Msisdn, row.MsisdnIndex, row.InitiatorCustomerNames, row.ReceiverPublicName,
+			).
+			Updates(updates)
+		if res.Error != nil {
+			return false, fmt.Errorf("failed to update payment %d in %s: %v", row.ID, table, res.Error)
+		}
+		if res.RowsAffected > 0 {
+			return true, nil
+		}
+	}
+
+	return false, fmt.Errorf("payment %d in %s kept changing while being re-encrypted", row.ID, table)
+}
+
+// reencryptColumns returns the column updates that bring a payment up to date with the keyring; nil when current
+func reencryptColumns(kr *pii.Keyring, row *encryptedColumns) (map[string]interface{}, error) {
+	msisdn, err := pii.Decrypt(row.Msisdn)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := kr.Stale(row.Msisdn) || kr.Stale(row.InitiatorCustomerNames) || kr.Stale(row.ReceiverPublicName) ||
+		row.MsisdnIndex != pii.BlindIndex(msisdn)
+	if !stale {
+		return nil, nil
+	}
+
+	updates := map[string]interface{}{
+		"msisdn_index": pii.BlindIndex(msisdn),
+	}
+
+	for col, value := range map[string]string{
+		"msisdn":                   row.Msisdn,
+		"initiator_customer_names": row.InitiatorCustomerNames,
+		"receiver_public_name":     row.ReceiverPublicName,
+	} {
+		plaintext, err := pii.Decrypt(value)
+		if err != nil {
+			return nil, err
+		}
+		updates[col], err = pii.Encrypt(plaintext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return updates, nil
+}
+
+// webhookSecretRow is the secret of a webhook subscription as stored
+type webhookSecretRow struct {
+	ID     uint
+	Secret string
+}
+
+// ReencryptWebhookSecrets rewrites webhook signing secrets that are in plaintext or encrypted under a key other
+// than the primary key.
+//
+// It returns the number of secrets rewritten. Deleted subscriptions are rewritten too.
+func ReencryptWebhookSecrets(ctx context.Context, sqlDB *gorm.DB) (int64, error) {
+	kr := pii.Current()
+	if kr == nil {
+		return 0, errors.New("no keyring is configured")
+	}
+
+	table := (&WebhookSubscription{}).TableName()
+
+	rows := make([]*webhookSecretRow, 0)
+	err := sqlDB.WithContext(ctx).Table(table).Select("id, secret").Order("id").Scan(&rows).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get webhook secrets: %v", err)
+	}
+
+	var total int64
+
+	for _, row := range rows {
+		if !kr.Stale(row.Secret) {
+			continue
+		}
+
+		secret, err := pii.Decrypt(row.Secret)
+		if err != nil {
+			return total, fmt.Errorf("failed to decrypt secret of webhook subscription %d: %v", row.ID, err)
+		}
+		secret, err = pii.Encrypt(secret)
+		if err != nil {
+			return total, fmt.Errorf("failed to encrypt secret of webhook subscription %d: %v", row.ID, err)
+		}
+
+		// Secrets are not changed once created, so a secret that differs was rewritten by another run
+		res := sqlDB.WithContext(ctx).Table(table).Where("id = ? AND secret = ?", row.ID, row.Secret).Update("secret", secret)
+		if res.Error != nil {
+			return total, fmt.Errorf("failed to update secret of webhook subscription %d: %v", row.ID, res.Error)
+		}
+		total += res.RowsAffected
+	}
+
+	return total, nil
+}
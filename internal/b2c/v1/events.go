@@ -0,0 +1,149 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"fmt"
+
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// EventEmitter emits payment events to watchers, webhook subscribers and the initiator publish channel
+type EventEmitter interface {
+	EmitPaymentEvent(ctx context.Context, eventType b2c.B2CEventType, db *Payment)
+}
+
+// events published when the initiator does not choose any
+var defaultPublishEvents = []b2c.B2CEventType{
+	b2c.B2CEventType_B2C_PAYMENT_SUCCEEDED,
+	b2c.B2CEventType_B2C_PAYMENT_FAILED,
+	b2c.B2CEventType_B2C_PAYMENT_SUBMIT_FAILED,
+	b2c.B2CEventType_B2C_PAYMENT_TIMED_OUT,
+}
+
+// SetPublishInfo stores the initiator publish preferences on the payment
+func SetPublishInfo(db *Payment, publish bool, publishInfo *b2c.PublishInfo) error {
+	db.Publish = publish
+	if publishInfo == nil {
+		db.PublishInfo = ""
+		return nil
+	}
+	bs, err := protojson.Marshal(publishInfo)
+	if err != nil {
+		return fmt.Errorf("failed to marshal publish info: %v", err)
+	}
+	db.PublishInfo = string(bs)
+	return nil
+}
+
+// GetPublishInfo reads the initiator publish preferences stored on the payment
+func GetPublishInfo(db *Payment) (*b2c.PublishInfo, error) {
+	publishInfo := &b2c.PublishInfo{}
+	if db.PublishInfo == "" {
+		return publishInfo, nil
+	}
+	err := protojson.Unmarshal([]byte(db.PublishInfo), publishInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal publish info: %v", err)
+	}
+	return publishInfo, nil
+}
+
+func publishesEvent(publishInfo *b2c.PublishInfo, eventType b2c.B2CEventType) bool {
+	if publishInfo.GetOnlyOnSuccess() {
+		return eventType == b2c.B2CEventType_B2C_PAYMENT_SUCCEEDED
+	}
+	eventTypes := publishInfo.GetEventTypes()
+	if len(eventTypes) == 0 {
+		eventTypes = defaultPublishEvents
+	}
+	for _, v := range eventTypes {
+		if v == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// EmitPaymentEvent appends the payment to the change feed, queues webhook deliveries and publishes
+// the event on the initiator channel if the payment publish preferences ask for it.
+//
+// Failures are logged since events are emitted after the payment state has been saved.
+func (b2cAPI *b2cAPIServer) EmitPaymentEvent(ctx context.Context, eventType b2c.B2CEventType, db *Payment) {
+	pb, err := PaymentProto(db)
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return
+	}
+
+	// Notify watchers of the change
+	_, err = b2cAPI.ChangeFeed.Append(ctx, pb)
+	if err != nil {
+		b2cAPI.Logger.Errorf("failed to append payment %d to change feed: %v", db.ID, err)
+	}
+
+	// Webhooks and channels deliver to the initiator who sees personal data according to the group they transferred with.
+	// The change feed keeps it unmasked as watchers are masked according to their own group.
+	access := &PIIAccess{
+		Actor:    db.InitiatorID,
+		Group:    db.InitiatorGroup,
+		Unmasked: b2cAPI.PIIPolicy.Unmasked(db.InitiatorGroup),
+	}
+	access.MaskPayment(pb)
+
+	// Queue the event for the initiator webhook subscriptions
+	deliveries, err := CreateWebhookDeliveries(ctx, b2cAPI.SQLDB, eventType, pb)
+	switch {
+	case err != nil:
+		b2cAPI.Logger.Errorf("failed to queue webhook deliveries for payment %d: %v", db.ID, err)
+	case deliveries > 0:
+		err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "WebhookPaymentEvent", fmt.Sprintf("event=%s deliveries=%d", eventType, deliveries), uint64(db.ID))
+		if err != nil {
+			b2cAPI.Logger.Errorln(err)
+		}
+	}
+
+	if !db.Publish {
+		return
+	}
+
+	publishInfo, err := GetPublishInfo(db)
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return
+	}
+
+	if publishInfo.GetChannelName() == "" || !publishesEvent(publishInfo, eventType) {
+		return
+	}
+
+	msg := &b2c.PublishMessage{
+		InitiatorId:    db.InitiatorID,
+		TransactionId:  pb.TransactionId,
+		MpesaReceiptId: pb.MpesaReceiptId,
+		Msisdn:         pb.Msisdn,
+		PublishInfo:    publishInfo,
+		Payment:        pb,
+		EventType:      eventType,
+	}
+
+	bs, err := proto.Marshal(msg)
+	if err != nil {
+		b2cAPI.Logger.Errorf("failed to marshal publish message: %v", err)
+		return
+	}
+
+	err = b2cAPI.publish(ctx, publishInfo.ChannelName, msg, bs)
+	if err != nil {
+		b2cAPI.Logger.Warningf("failed to publish %s for payment %d: %v", eventType, db.ID, err)
+		return
+	}
+
+	b2cAPI.Logger.Infof("%s for payment %d published on channel %s", eventType, db.ID, publishInfo.ChannelName)
+
+	err = AuditPIIAccess(ctx, b2cAPI.SQLDB, access, "PublishPaymentEvent", fmt.Sprintf("event=%s channel=%s", eventType, publishInfo.ChannelName), uint64(db.ID))
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+	}
+}
@@ -0,0 +1,345 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	exportChunkSize = 32 * 1024
+	exportSheet     = "Sheet1"
+
+	// maxXLSXExportRows is the most payments a sheet holds below its header
+	maxXLSXExportRows = excelize.TotalRows - 1
+)
+
+type exportColumn struct {
+	name   string
+	header string
+	value  func(*Payment) interface{}
+}
+
+func formatExportTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// exportColumns are the columns that can be exported in the order they appear in the file
+var exportColumns = []*exportColumn{
+	{"transaction_id", "Transaction ID", func(db *Payment) interface{} { return db.ID }},
+	{"initiator_id", "Initiator ID", func(db *Payment) interface{} { return db.InitiatorID }},
+	{"initiator_customer_reference", "Customer Reference", func(db *Payment) interface{} { return db.InitiatorCustomerReference }},
+	{"initiator_customer_names", "Customer Names", func(db *Payment) interface{} { return db.InitiatorCustomerNames }},
+	{"initiator_transaction_reference", "Transaction Reference", func(db *Payment) interface{} { return db.InitiatorTransactionReference }},
+	{"org_short_code", "Short Code", func(db *Payment) interface{} { return db.OrgShortCode }},
+	{"command_id", "Command ID", func(db *Payment) interface{} { return db.CommandId }},
+	{"msisdn", "Msisdn", func(db *Payment) interface{} { return db.Msisdn }},
+	{"amount", "Amount", func(db *Payment) interface{} { return db.TransactionAmount }},
+	{"conversation_id", "Conversation ID", func(db *Payment) interface{} { return db.ConversationID }},
+	{"original_conversation_id", "Originator Conversation ID", func(db *Payment) interface{} { return db.OriginatorConversationID }},
+	{"b2c_response_description", "Response Description", func(db *Payment) interface{} { return db.ResponseDescription }},
+	{"b2c_response_code", "Response Code", func(db *Payment) interface{} { return db.ResponseCode }},
+	{"b2c_result_description", "Result Description", func(db *Payment) interface{} { return db.ResultDescription }},
+	{"b2c_result_code", "Result Code", func(db *Payment) interface{} { return db.ResultCode }},
+	{"receiver_party_public_name", "Receiver Public Name", func(db *Payment) interface{} { return db.ReceiverPublicName }},
+	{"mpesa_receipt_id", "Mpesa Receipt", func(db *Payment) interface{} { return db.MpesaReceiptId.String }},
+	{"working_account_funds", "Working Account Funds", func(db *Payment) interface{} { return db.WorkingAccountFunds }},
+	{"utility_account_funds", "Utility Account Funds", func(db *Payment) interface{} { return db.UtilityAccountFunds }},
+	{"charges_paid_account_funds", "Charges Paid Account Funds", func(db *Payment) interface{} { return db.ChargesPaidAccountFunds }},
+	{"mpesa_charges", "Mpesa Charges", func(db *Payment) interface{} { return db.MpesaCharges }},
+	{"system_charges", "System Charges", func(db *Payment) interface{} { return db.SystemCharges }},
+	{"recipient_registered", "Recipient Registered", func(db *Payment) interface{} { return db.RecipientRegistered }},
+	{"b2c_status", "Status", func(db *Payment) interface{} { return db.B2CStatus }},
+	{"source", "Source", func(db *Payment) interface{} { return db.Source }},
+	{"tag", "Tag", func(db *Payment) interface{} { return db.Tag }},
+	{"succeeded", "Succeeded", func(db *Payment) interface{} { return db.Succeeded == "YES" }},
+	{"processed", "Processed", func(db *Payment) interface{} { return db.Processed == "YES" }},
+	{"transaction_time", "Transaction Time", func(db *Payment) interface{} { return formatExportTime(db.TransactionTime.Time) }},
+	{"create_date", "Create Date", func(db *Payment) interface{} { return formatExportTime(db.CreatedAt) }},
+}
+
+func getExportColumns(names []string) ([]*exportColumn, error) {
+	if len(names) == 0 {
+		return exportColumns, nil
+	}
+	cols := make([]*exportColumn, 0, len(names))
+	for _, name := range names {
+		var col *exportColumn
+		for _, v := range exportColumns {
+			if v.name == name {
+				col = v
+				break
+			}
+		}
+		if col == nil {
+			return nil, errs.WrapMessagef(codes.InvalidArgument, "unknown export column %q", name)
+		}
+		cols = append(cols, col)
+	}
+	return cols, nil
+}
+
+// ExportContentType returns the content type of files exported in the format
+func ExportContentType(format b2c.ExportFormat) string {
+	if format == b2c.ExportFormat_EXPORT_FORMAT_XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv"
+}
+
+// ExportFileName returns the name of files exported in the format
+func ExportFileName(format b2c.ExportFormat) string {
+	ext := "csv"
+	if format == b2c.ExportFormat_EXPORT_FORMAT_XLSX {
+		ext = "xlsx"
+	}
+	return fmt.Sprintf("b2c-payments-%s.%s", time.Now().UTC().Format("20060102T150405Z"), ext)
+}
+
+// ValidateExportRequest checks the export request before any data is written
+func ValidateExportRequest(req *b2c.ExportB2CPaymentsRequest) error {
+	switch {
+	case req == nil:
+		return errs.MissingField("export request")
+	case req.Format != b2c.ExportFormat_EXPORT_FORMAT_UNSPECIFIED &&
+		req.Format != b2c.ExportFormat_EXPORT_FORMAT_CSV && req.Format != b2c.ExportFormat_EXPORT_FORMAT_XLSX:
+		return errs.IncorrectVal("export format")
+	}
+	_, err := getExportColumns(req.Columns)
+	return err
+}
+
+// IsExportRequestError reports whether an export failed because of the request, in which case nothing was written
+func IsExportRequestError(err error) bool {
+	return status.Code(err) == codes.InvalidArgument
+}
+
+// ExportPayments writes payments matching the request filter to w.
+//
+// Rows are read from a database cursor; XLSX rows are buffered by excelize in a temporary file until the workbook is written
+// and are limited to the rows of a sheet.
+// Payments are limited to the scope of the caller in ctx. Personal data is masked unless the access is unmasked,
+// in which case the export is audited once written.
+func ExportPayments(ctx context.Context, sqlDB *gorm.DB, req *b2c.ExportB2CPaymentsRequest, access *PIIAccess, w io.Writer) error {
+	err := ValidateExportRequest(req)
+	if err != nil {
+		return err
+	}
+
+	cols, _ := getExportColumns(req.Columns)
+
+	db, err := filterPayments(scopePayments(ctx, sqlDB.WithContext(ctx).Model(&Payment{})), req.Filter)
+	if err != nil {
+		return err
+	}
+
+	db, err = orderPayments(db, req.Filter, nil)
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query payments: %v", err)
+	}
+	defer rows.Close()
+
+	header := make([]interface{}, 0, len(cols))
+	for _, col := range cols {
+		header = append(header, col.header)
+	}
+
+	var exported []uint64
+
+	// Reads the next payment into a row
+	next := func(row []interface{}) (bool, error) {
+		if !rows.Next() {
+			return false, rows.Err()
+		}
+		paymentDB := &Payment{}
+		err := sqlDB.ScanRows(rows, paymentDB)
+		if err != nil {
+			return false, fmt.Errorf("failed to scan payment: %v", err)
+		}
+		access.maskModel(paymentDB)
+		exported = append(exported, uint64(paymentDB.ID))
+		for i, col := range cols {
+			row[i] = col.value(paymentDB)
+		}
+		return true, nil
+	}
+
+	row := make([]interface{}, len(cols))
+
+	if req.Format == b2c.ExportFormat_EXPORT_FORMAT_XLSX {
+		f := excelize.NewFile()
+		defer f.Close()
+
+		sw, err := f.NewStreamWriter(exportSheet)
+		if err != nil {
+			return fmt.Errorf("failed to create sheet writer: %v", err)
+		}
+
+		err = sw.SetRow("A1", header)
+		if err != nil {
+			return fmt.Errorf("failed to write header: %v", err)
+		}
+
+		for i := 2; ; i++ {
+			ok, err := next(row)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			// Nothing has been written yet as the workbook is written once complete
+			if i > excelize.TotalRows {
+				return errs.WrapMessagef(
+					codes.InvalidArgument, "xlsx exports are limited to %d payments; narrow the filter or export as csv", maxXLSXExportRows,
+				)
+			}
+			cell, _ := excelize.CoordinatesToCellName(1, i)
+			err = sw.SetRow(cell, row)
+			if err != nil {
+				return fmt.Errorf("failed to write row: %v", err)
+			}
+		}
+
+		err = sw.Flush()
+		if err != nil {
+			return fmt.Errorf("failed to flush sheet: %v", err)
+		}
+
+		err = f.Write(w)
+		if err != nil {
+			return err
+		}
+
+		return AuditPIIAccess(ctx, sqlDB, access, "ExportB2CPayments", "format=xlsx", exported...)
+	}
+
+	cw := csv.NewWriter(w)
+	record := make([]string, len(cols))
+
+	for i, v := range header {
+		record[i] = fmt.Sprint(v)
+	}
+	err = cw.Write(record)
+	if err != nil {
+		return err
+	}
+
+	for {
+		ok, err := next(row)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		for i, v := range row {
+			record[i] = fmt.Sprint(v)
+		}
+		err = cw.Write(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	err = cw.Error()
+	if err != nil {
+		return err
+	}
+
+	return AuditPIIAccess(ctx, sqlDB, access, "ExportB2CPayments", "format=csv", exported...)
+}
+
+// chunkWriter sends written bytes to the export stream in chunks
+type chunkWriter struct {
+	stream      b2c.B2CV1_ExportB2CPaymentsServer
+	contentType string
+	fileName    string
+	buf         []byte
+	sent        bool
+}
+
+func (cw *chunkWriter) Write(p []byte) (int, error) {
+	cw.buf = append(cw.buf, p...)
+	for len(cw.buf) >= exportChunkSize {
+		err := cw.send(cw.buf[:exportChunkSize])
+		if err != nil {
+			return 0, err
+		}
+		cw.buf = cw.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+func (cw *chunkWriter) send(data []byte) error {
+	chunk := &b2c.ExportB2CPaymentsChunk{Data: data}
+	// File details are sent with the first chunk only
+	if !cw.sent {
+		chunk.ContentType = cw.contentType
+		chunk.FileName = cw.fileName
+		cw.sent = true
+	}
+	return cw.stream.Send(chunk)
+}
+
+func (cw *chunkWriter) Close() error {
+	if len(cw.buf) == 0 && cw.sent {
+		return nil
+	}
+	return cw.send(cw.buf)
+}
+
+func (b2cAPI *b2cAPIServer) ExportB2CPayments(
+	req *b2c.ExportB2CPaymentsRequest, stream b2c.B2CV1_ExportB2CPaymentsServer,
+) error {
+	ctx := stream.Context()
+
+	// Authorization
+	payload, err := b2cAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Validation
+	err = ValidateExportRequest(req)
+	if err != nil {
+		return err
+	}
+
+	cw := &chunkWriter{
+		stream:      stream,
+		contentType: ExportContentType(req.Format),
+		fileName:    ExportFileName(req.Format),
+		buf:         make([]byte, 0, exportChunkSize),
+	}
+
+	err = ExportPayments(ctx, b2cAPI.SQLDB, req, b2cAPI.PIIPolicy.Access(payload), cw)
+	switch {
+	case err == nil:
+	case IsExportRequestError(err):
+		return err
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return errs.WrapMessage(codes.Internal, "failed to export b2c payments")
+	}
+
+	return cw.Close()
+}
@@ -0,0 +1,155 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gidyon/mpesa-b2c/internal/health"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"gorm.io/gorm"
+)
+
+// Workers whose liveness is reported
+const (
+	workerDailyStats      = "daily_stats"
+	workerHourlyStats     = "hourly_stats"
+	workerWebhookDelivery = "webhook_delivery"
+	workerArchival        = "payment_archival"
+)
+
+// webhookOverdueAfter is how long a pending delivery may wait past its attempt time before counting as backlog
+const webhookOverdueAfter = time.Minute
+
+// accessTokenState is the outcome of access token refreshes
+type accessTokenState struct {
+	mu          sync.RWMutex
+	updatedAt   time.Time
+	expiresAt   time.Time
+	lastErr     error
+	lastErrTime time.Time
+}
+
+func (s *accessTokenState) refreshed(expiresIn time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updatedAt = time.Now()
+	s.expiresAt = s.updatedAt.Add(expiresIn)
+	s.lastErr = nil
+}
+
+func (s *accessTokenState) failed(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastErr = err
+	s.lastErrTime = time.Now()
+}
+
+// heartbeat is the last time a worker woke up
+type heartbeat struct {
+	mu       sync.Mutex
+	interval time.Duration
+	last     time.Time
+}
+
+// heartbeat records that the worker is running; workers are stale after missing two wake ups
+func (b2cAPI *b2cAPIServer) heartbeat(worker string, interval time.Duration) {
+	v, _ := b2cAPI.heartbeats.LoadOrStore(worker, &heartbeat{interval: interval})
+	hb := v.(*heartbeat)
+	hb.mu.Lock()
+	hb.last = time.Now()
+	hb.mu.Unlock()
+}
+
+func (b2cAPI *b2cAPIServer) registerHealthChecks(registry *health.Registry) {
+	registry.AddReadinessCheck("access_token", b2cAPI.checkAccessToken)
+	registry.AddLivenessCheck("workers", b2cAPI.checkWorkers)
+	registry.AddReadinessCheck("webhook_backlog", b2cAPI.checkWebhookBacklog)
+}
+
+func (b2cAPI *b2cAPIServer) checkAccessToken(context.Context) (map[string]interface{}, error) {
+	s := b2cAPI.tokenState
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	detail := map[string]interface{}{}
+	if !s.updatedAt.IsZero() {
+		detail["age_seconds"] = int64(time.Since(s.updatedAt).Seconds())
+		detail["expires_in_seconds"] = int64(time.Until(s.expiresAt).Seconds())
+	}
+	if s.lastErr != nil {
+		detail["last_error"] = s.lastErr.Error()
+		detail["last_error_time"] = s.lastErrTime.UTC().Format(time.RFC3339)
+	}
+
+	switch {
+	case s.updatedAt.IsZero() && s.lastErr != nil:
+		return detail, fmt.Errorf("access token not obtained: %v", s.lastErr)
+	case s.updatedAt.IsZero():
+		return detail, errors.New("access token not obtained yet")
+	case time.Now().After(s.expiresAt):
+		return detail, errors.New("access token expired")
+	}
+
+	return detail, nil
+}
+
+func (b2cAPI *b2cAPIServer) checkWorkers(context.Context) (map[string]interface{}, error) {
+	var (
+		detail = map[string]interface{}{}
+		stale  = make([]string, 0)
+	)
+
+	b2cAPI.heartbeats.Range(func(key, value interface{}) bool {
+		hb := value.(*heartbeat)
+		hb.mu.Lock()
+		since := time.Since(hb.last)
+		interval := hb.interval
+		hb.mu.Unlock()
+
+		detail[key.(string)] = map[string]interface{}{
+			"last_heartbeat_seconds": int64(since.Seconds()),
+			"interval_seconds":       int64(interval.Seconds()),
+		}
+		if since > 2*interval+time.Minute {
+			stale = append(stale, key.(string))
+		}
+		return true
+	})
+
+	if len(stale) > 0 {
+		return detail, fmt.Errorf("workers not running: %v", stale)
+	}
+
+	return detail, nil
+}
+
+func (b2cAPI *b2cAPIServer) checkWebhookBacklog(ctx context.Context) (map[string]interface{}, error) {
+	var pending, overdue int64
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Model(&WebhookDelivery{}).
+		Where("status = ?", b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING.String())
+
+	err := db.Session(&gorm.Session{}).Count(&pending).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Session(&gorm.Session{}).Where("next_attempt_at < ?", time.Now().UTC().Add(-webhookOverdueAfter)).Count(&overdue).Error
+	if err != nil {
+		return nil, err
+	}
+
+	detail := map[string]interface{}{
+		"pending": pending,
+		"overdue": overdue,
+	}
+
+	if b2cAPI.MaxWebhookBacklog > 0 && overdue > b2cAPI.MaxWebhookBacklog {
+		return detail, fmt.Errorf("%d overdue webhook deliveries exceeds %d", overdue, b2cAPI.MaxWebhookBacklog)
+	}
+
+	return detail, nil
+}
@@ -2,11 +2,14 @@ package b2c_app_v1
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
+	"github.com/gidyon/mpesa-b2c/internal/pii"
 	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
 	"github.com/spf13/viper"
+	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/gorm"
 )
 
@@ -15,15 +18,21 @@ const B2CTable = "b2c_transactions"
 
 // Payment is B2C payment model
 type Payment struct {
-	ID                         uint   `gorm:"primaryKey;autoIncrement"`
-	InitiatorID                string `gorm:"index;type:varchar(50)"`
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	InitiatorID string `gorm:"index;type:varchar(50)"`
+	// InitiatorGroup is the auth group of the initiator which decides whether published messages are masked
+	InitiatorGroup             string `gorm:"type:varchar(50)"`
 	InitiatorCustomerReference string `gorm:"index;type:varchar(50)"`
-	InitiatorCustomerNames     string `gorm:"type:varchar(50)"`
+	InitiatorCustomerNames     string `gorm:"type:varchar(255);serializer:pii"`
 
-	Msisdn            string  `gorm:"index;type:varchar(15)"`
+	InitiatorTransactionReference string `gorm:"index;type:varchar(50)"`
+
+	Msisdn string `gorm:"type:varchar(255);serializer:pii"`
+	// MsisdnIndex is the blind index of the msisdn which can be encrypted
+	MsisdnIndex       string  `gorm:"index;type:varchar(64)"`
 	OrgShortCode      string  `gorm:"index;type:varchar(15)"`
 	CommandId         string  `gorm:"index;type:varchar(30)"`
-	TransactionAmount float32 `gorm:"index;type:float(10)"`
+	TransactionAmount float32 `gorm:"index"`
 
 	ConversationID           string `gorm:"index;type:varchar(50);not null"`
 	OriginatorConversationID string `gorm:"index;type:varchar(50);not null"`
@@ -32,23 +41,35 @@ type Payment struct {
 	ResultCode               string `gorm:"index;type:varchar(10)"`
 	ResultDescription        string `gorm:"type:varchar(300)"`
 
-	WorkingAccountFunds float32        `gorm:"type:float(10)"`
-	UtilityAccountFunds float32        `gorm:"type:float(10)"`
-	MpesaCharges        float32        `gorm:"type:float(10)"`
-	SystemCharges       float32        `gorm:"type:float(10)"`
-	RecipientRegistered bool           `gorm:"index;type:tinyint(1)"`
+	// ReversalConversationID is the conversation id of the reversal of the payment
+	ReversalConversationID string `gorm:"index;type:varchar(50)"`
+
+	WorkingAccountFunds float32
+	UtilityAccountFunds float32
+	// ChargesPaidAccountFunds is the balance of the charges paid account after the payment
+	ChargesPaidAccountFunds float32
+	// MpesaCharges is the charge of the payment worked out from the charges paid account balances
+	MpesaCharges        float32
+	SystemCharges       float32
+	RecipientRegistered bool           `gorm:"index"`
 	MpesaReceiptId      sql.NullString `gorm:"index;type:varchar(50);unique"`
-	ReceiverPublicName  string         `gorm:"type:varchar(50)"`
+	ReceiverPublicName  string         `gorm:"type:varchar(255);serializer:pii"`
 
 	B2CStatus string `gorm:"index;type:varchar(30);column:b2c_status"`
 	Source    string `gorm:"index;type:varchar(30)"`
 	Tag       string `gorm:"index;type:varchar(30)"`
-	Succeeded string `gorm:"index;type:enum('YES','NO', 'UNKNOWN');default:NO"`
-	Processed string `gorm:"index;type:enum('YES','NO');default:NO"`
+	Succeeded string `gorm:"index;type:varchar(7);default:NO"`
+	Processed string `gorm:"index;type:varchar(3);default:NO"`
+
+	Publish     bool
+	PublishInfo string `gorm:"type:text"`
+
+	// W3C traceparent of the transfer request so that the result callback can link to its trace
+	TraceParent string `gorm:"type:varchar(60)"`
 
-	TransactionTime sql.NullTime `gorm:"index;type:datetime(6)"`
-	UpdatedAt       time.Time    `gorm:"autoUpdateTime;type:datetime(6)"`
-	CreatedAt       time.Time    `gorm:"index;autoCreateTime;type:datetime(6);not null"`
+	TransactionTime sql.NullTime `gorm:"index;precision:6"`
+	UpdatedAt       time.Time    `gorm:"autoUpdateTime;precision:6"`
+	CreatedAt       time.Time    `gorm:"index;autoCreateTime;precision:6;not null"`
 }
 
 // TableName is table name for model
@@ -60,16 +81,64 @@ func (*Payment) TableName() string {
 	return B2CTable
 }
 
+// BeforeSave keeps the msisdn blind index in step with the msisdn
+func (db *Payment) BeforeSave(*gorm.DB) error {
+	db.MsisdnIndex = pii.BlindIndex(db.Msisdn)
+	return nil
+}
+
+// msisdnColumn is the column that identifies a msisdn in queries; encrypted msisdns differ on every row
+func msisdnColumn() string {
+	if pii.Enabled() {
+		return "msisdn_index"
+	}
+	return "msisdn"
+}
+
+// encryptPII copies the payment with its personal data encrypted for storage outside the database
+func (db *Payment) encryptPII() (*Payment, error) {
+	var (
+		encrypted = *db
+		err       error
+	)
+	for _, v := range []*string{&encrypted.Msisdn, &encrypted.InitiatorCustomerNames, &encrypted.ReceiverPublicName} {
+		*v, err = pii.Encrypt(*v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &encrypted, nil
+}
+
+// decryptPII decrypts personal data of a payment from encryptPII
+func (db *Payment) decryptPII() error {
+	var err error
+	for _, v := range []*string{&db.Msisdn, &db.InitiatorCustomerNames, &db.ReceiverPublicName} {
+		*v, err = pii.Decrypt(*v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DailyStat contains statistics for a day
 type DailyStat struct {
 	ID                     uint   `gorm:"primaryKey;autoIncrement"`
 	OrgShortCode           string `gorm:"index;type:varchar(20);not null"`
 	Date                   string `gorm:"index;type:varchar(10);not null"`
-	TotalTransactions      int32  `gorm:"type:int(10);not null"`
+	TotalTransactions      int32  `gorm:"not null"`
 	SuccessfulTransactions int32
 	FailedTransactions     int32
-	TotalAmountTransacted  float32        `gorm:"index;type:float(15)"`
-	TotalCharges           float32        `gorm:"index;type:float(15)"`
+	TotalAmountTransacted  float32 `gorm:"index"`
+	TotalCharges           float32 `gorm:"index"`
+	TotalMpesaCharges      float32
+	UniqueRecipients       int64
+	PendingTransactions    int64
+	UnknownTransactions    int64
+	CommandStats           string `gorm:"type:text"`
+	AverageLatencyMillis   int64
+	P95LatencyMillis       int64
 	CreatedAt              time.Time      `gorm:"autoCreateTime"`
 	UpdatedAt              time.Time      `gorm:"autoCreateTime"`
 	DeletedAt              gorm.DeletedAt `gorm:"index"`
@@ -86,41 +155,87 @@ func (*DailyStat) TableName() string {
 }
 
 func PaymentProto(db *Payment) (*b2c.B2CPayment, error) {
+	// Payments read through gorm are already decrypted
+	err := db.decryptPII()
+	if err != nil {
+		return nil, err
+	}
+
 	pb := &b2c.B2CPayment{
-		TransactionId:              uint64(db.ID),
-		InitiatorId:                db.InitiatorID,
-		InitiatorCustomerReference: db.InitiatorCustomerReference,
-		InitiatorCustomerNames:     db.InitiatorCustomerNames,
-		OrgShortCode:               db.OrgShortCode,
-		CommandId:                  b2c.CommandId(b2c.CommandId_value[db.CommandId]),
-		Msisdn:                     db.Msisdn,
-		Amount:                     db.TransactionAmount,
-		ConversationId:             db.ConversationID,
-		OriginalConversationId:     db.OriginatorConversationID,
-		B2CResponseDescription:     db.ResponseDescription,
-		B2CResponseCode:            db.ResponseCode,
-		B2CResultDescription:       db.ResultDescription,
-		B2CResultCode:              db.ResultCode,
-		ReceiverPartyPublicName:    db.ReceiverPublicName,
-		MpesaReceiptId:             db.MpesaReceiptId.String,
-		WorkingAccountFunds:        db.WorkingAccountFunds,
-		UtilityAccountFunds:        db.UtilityAccountFunds,
-		MpesaCharges:               db.MpesaCharges,
-		SystemCharges:              db.SystemCharges,
-		RecipientRegistered:        db.RecipientRegistered,
-		B2CStatus:                  b2c.B2CStatus(b2c.B2CStatus_value[db.B2CStatus]),
-		Source:                     db.Source,
-		Tag:                        db.Tag,
-		Succeeded:                  db.Succeeded == "YES",
-		Processed:                  db.Processed == "YES",
-		TransactionTimestamp:       db.TransactionTime.Time.UTC().Unix(),
-		CreateDate:                 db.CreatedAt.UTC().Format(time.RFC3339),
+		TransactionId:                 uint64(db.ID),
+		InitiatorId:                   db.InitiatorID,
+		InitiatorCustomerReference:    db.InitiatorCustomerReference,
+		InitiatorCustomerNames:        db.InitiatorCustomerNames,
+		OrgShortCode:                  db.OrgShortCode,
+		CommandId:                     b2c.CommandId(b2c.CommandId_value[db.CommandId]),
+		Msisdn:                        db.Msisdn,
+		Amount:                        db.TransactionAmount,
+		ConversationId:                db.ConversationID,
+		OriginalConversationId:        db.OriginatorConversationID,
+		B2CResponseDescription:        db.ResponseDescription,
+		B2CResponseCode:               db.ResponseCode,
+		B2CResultDescription:          db.ResultDescription,
+		B2CResultCode:                 db.ResultCode,
+		ReceiverPartyPublicName:       db.ReceiverPublicName,
+		MpesaReceiptId:                db.MpesaReceiptId.String,
+		WorkingAccountFunds:           db.WorkingAccountFunds,
+		UtilityAccountFunds:           db.UtilityAccountFunds,
+		ChargesPaidAccountFunds:       db.ChargesPaidAccountFunds,
+		MpesaCharges:                  db.MpesaCharges,
+		SystemCharges:                 db.SystemCharges,
+		RecipientRegistered:           db.RecipientRegistered,
+		B2CStatus:                     b2c.B2CStatus(b2c.B2CStatus_value[db.B2CStatus]),
+		Source:                        db.Source,
+		Tag:                           db.Tag,
+		Succeeded:                     db.Succeeded == "YES",
+		Processed:                     db.Processed == "YES",
+		TransactionTimestamp:          db.TransactionTime.Time.UTC().Unix(),
+		CreateDate:                    db.CreatedAt.UTC().Format(time.RFC3339),
+		InitiatorTransactionReference: db.InitiatorTransactionReference,
 	}
 	return pb, nil
 }
 
+// marshalCommandStats encodes command stats as a JSON array of protojson messages
+func marshalCommandStats(stats []*b2c.CommandIdStat) ([]byte, error) {
+	msgs := make([]json.RawMessage, 0, len(stats))
+	for _, stat := range stats {
+		bs, err := protojson.Marshal(stat)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, bs)
+	}
+	return json.Marshal(msgs)
+}
+
+// unmarshalCommandStats decodes command stats from marshalCommandStats.
+//
+// Stats written with encoding/json before are read too as protojson accepts field names and enum numbers.
+func unmarshalCommandStats(bs []byte) ([]*b2c.CommandIdStat, error) {
+	msgs := make([]json.RawMessage, 0)
+	err := json.Unmarshal(bs, &msgs)
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]*b2c.CommandIdStat, 0, len(msgs))
+	for _, msg := range msgs {
+		stat := &b2c.CommandIdStat{}
+		err = protojson.Unmarshal(msg, stat)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, stat)
+	}
+	return stats, nil
+}
+
 // StatModel gets mpesa statistics model from protobuf message
 func StatModel(pb *b2c.DailyStat) (*DailyStat, error) {
+	commandStats, err := marshalCommandStats(pb.CommandStats)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal command stats: %v", err)
+	}
 	return &DailyStat{
 		ID:                     0,
 		OrgShortCode:           pb.OrgShortCode,
@@ -130,6 +245,13 @@ func StatModel(pb *b2c.DailyStat) (*DailyStat, error) {
 		FailedTransactions:     int32(pb.FailedTransactions),
 		TotalAmountTransacted:  pb.TotalAmountTransacted,
 		TotalCharges:           pb.TotalCharges,
+		TotalMpesaCharges:      pb.TotalMpesaCharges,
+		UniqueRecipients:       pb.UniqueRecipients,
+		PendingTransactions:    pb.PendingTransactions,
+		UnknownTransactions:    pb.UnknownTransactions,
+		CommandStats:           string(commandStats),
+		AverageLatencyMillis:   pb.AverageCallbackLatencyMillis,
+		P95LatencyMillis:       pb.P95CallbackLatencyMillis,
 		CreatedAt:              time.Time{},
 		UpdatedAt:              time.Time{},
 		DeletedAt:              gorm.DeletedAt{},
@@ -138,16 +260,31 @@ func StatModel(pb *b2c.DailyStat) (*DailyStat, error) {
 
 // StatProto gets mpesa statistics protobuf from model
 func StatProto(db *DailyStat) (*b2c.DailyStat, error) {
+	commandStats := make([]*b2c.CommandIdStat, 0)
+	if db.CommandStats != "" {
+		var err error
+		commandStats, err = unmarshalCommandStats([]byte(db.CommandStats))
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal command stats: %v", err)
+		}
+	}
 	return &b2c.DailyStat{
-		StatId:                 fmt.Sprint(db.ID),
-		Date:                   "",
-		OrgShortCode:           db.OrgShortCode,
-		TotalTransactions:      db.TotalTransactions,
-		SuccessfulTransactions: int64(db.SuccessfulTransactions),
-		FailedTransactions:     int64(db.FailedTransactions),
-		TotalAmountTransacted:  db.TotalAmountTransacted,
-		TotalCharges:           db.TotalCharges,
-		CreateTimeSeconds:      db.CreatedAt.Unix(),
-		UpdateTimeSeconds:      db.UpdatedAt.Unix(),
+		StatId:                       fmt.Sprint(db.ID),
+		Date:                         db.Date,
+		OrgShortCode:                 db.OrgShortCode,
+		TotalTransactions:            db.TotalTransactions,
+		SuccessfulTransactions:       int64(db.SuccessfulTransactions),
+		FailedTransactions:           int64(db.FailedTransactions),
+		TotalAmountTransacted:        db.TotalAmountTransacted,
+		TotalCharges:                 db.TotalCharges,
+		CreateTimeSeconds:            db.CreatedAt.Unix(),
+		UpdateTimeSeconds:            db.UpdatedAt.Unix(),
+		TotalMpesaCharges:            db.TotalMpesaCharges,
+		UniqueRecipients:             db.UniqueRecipients,
+		PendingTransactions:          db.PendingTransactions,
+		UnknownTransactions:          db.UnknownTransactions,
+		CommandStats:                 commandStats,
+		AverageCallbackLatencyMillis: db.AverageLatencyMillis,
+		P95CallbackLatencyMillis:     db.P95LatencyMillis,
 	}, nil
 }
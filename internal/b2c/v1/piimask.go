@@ -0,0 +1,168 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const piiAccessLogsTable = "b2c_pii_access_logs"
+
+// maximum number of payment ids recorded in one access log entry
+const maxAuditedPaymentIDs = 1000
+
+// PIIPolicy decides which callers see personal data in payments unmasked
+type PIIPolicy struct {
+	AuthAPI *auth.API
+	// UnmaskedGroups see personal data unmasked along with the admin groups
+	UnmaskedGroups []string
+}
+
+// Access returns how the caller with the payload sees personal data; callers without a payload see it masked
+func (p *PIIPolicy) Access(payload *auth.Payload) *PIIAccess {
+	if payload == nil {
+		return &PIIAccess{}
+	}
+	return &PIIAccess{
+		Actor:    payload.ID,
+		Group:    payload.Group,
+		Unmasked: p.Unmasked(payload.Group),
+	}
+}
+
+// Unmasked reports whether the group sees personal data unmasked
+func (p *PIIPolicy) Unmasked(group string) bool {
+	if group == "" {
+		return false
+	}
+	if p.AuthAPI != nil && p.AuthAPI.IsAdmin(group) {
+		return true
+	}
+	for _, v := range p.UnmaskedGroups {
+		if v == group {
+			return true
+		}
+	}
+	return false
+}
+
+// PIIAccess is how a caller sees personal data in payments
+type PIIAccess struct {
+	Actor    string
+	Group    string
+	Unmasked bool
+}
+
+// MaskPayment masks the personal data of the payment unless the caller sees it unmasked
+func (a *PIIAccess) MaskPayment(pb *b2c.B2CPayment) {
+	if a.Unmasked || pb == nil {
+		return
+	}
+	pb.Msisdn = MaskMsisdn(pb.Msisdn)
+	pb.InitiatorCustomerNames = MaskName(pb.InitiatorCustomerNames)
+	pb.ReceiverPartyPublicName = MaskName(pb.ReceiverPartyPublicName)
+}
+
+// maskModel masks the personal data of the payment model unless the caller sees it unmasked
+func (a *PIIAccess) maskModel(db *Payment) {
+	if a.Unmasked {
+		return
+	}
+	db.Msisdn = MaskMsisdn(db.Msisdn)
+	db.InitiatorCustomerNames = MaskName(db.InitiatorCustomerNames)
+	db.ReceiverPublicName = MaskName(db.ReceiverPublicName)
+}
+
+// MaskMsisdn keeps the country and network prefix and the last three digits of a phone number e.g. 2547*****123
+func MaskMsisdn(msisdn string) string {
+	rs := []rune(msisdn)
+	switch {
+	case len(rs) == 0:
+		return ""
+	case len(rs) < 8:
+		return strings.Repeat("*", len(rs))
+	}
+	for i := 4; i < len(rs)-3; i++ {
+		rs[i] = '*'
+	}
+	return string(rs)
+}
+
+// MaskName keeps the first letter of each name e.g. J*** D**
+func MaskName(name string) string {
+	names := strings.Fields(name)
+	for i, v := range names {
+		rs := []rune(v)
+		for j := 1; j < len(rs); j++ {
+			rs[j] = '*'
+		}
+		names[i] = string(rs)
+	}
+	return strings.Join(names, " ")
+}
+
+// PIIAccessLog records that personal data in payments was seen unmasked
+type PIIAccessLog struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	Actor        string `gorm:"index;type:varchar(50)"`
+	ActorGroup   string `gorm:"type:varchar(50)"`
+	Action       string `gorm:"index;type:varchar(50)"`
+	Detail       string `gorm:"type:varchar(255)"`
+	PaymentIDs   string `gorm:"type:text"`
+	PaymentCount int64
+	CreatedAt    time.Time `gorm:"index;autoCreateTime;precision:6;not null"`
+}
+
+// TableName is table name for model
+func (*PIIAccessLog) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), piiAccessLogsTable)
+	}
+	return piiAccessLogsTable
+}
+
+// AuditPIIAccess writes an access log entry when the caller saw the payments unmasked.
+//
+// Reads fail when the entry cannot be written so that no unmasked view goes unrecorded.
+func AuditPIIAccess(ctx context.Context, sqlDB *gorm.DB, access *PIIAccess, action, detail string, paymentIDs ...uint64) error {
+	if !access.Unmasked {
+		return nil
+	}
+
+	ids := make([]string, 0, len(paymentIDs))
+	for i, id := range paymentIDs {
+		if i == maxAuditedPaymentIDs {
+			break
+		}
+		ids = append(ids, fmt.Sprint(id))
+	}
+
+	err := sqlDB.WithContext(ctx).Create(&PIIAccessLog{
+		Actor:        firstN(access.Actor, 50),
+		ActorGroup:   firstN(access.Group, 50),
+		Action:       action,
+		Detail:       firstN(detail, 255),
+		PaymentIDs:   strings.Join(ids, ","),
+		PaymentCount: int64(len(paymentIDs)),
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to write pii access log: %v", err)
+	}
+
+	return nil
+}
+
+// piiAccess is how the caller in the context sees personal data
+func (b2cAPI *b2cAPIServer) piiAccess(ctx context.Context) *PIIAccess {
+	payload, err := b2cAPI.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return &PIIAccess{}
+	}
+	return b2cAPI.PIIPolicy.Access(payload)
+}
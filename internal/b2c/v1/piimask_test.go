@@ -0,0 +1,97 @@
+package b2c_app_v1
+
+import (
+	"testing"
+
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+)
+
+func TestMaskMsisdn(t *testing.T) {
+	tests := []struct {
+		msisdn string
+		want   string
+	}{
+		{"", ""},
+		{"254712345678", "2547*****678"},
+		{"+254712345678", "+254******678"},
+		{"0712345678", "0712***678"},
+		{"12345678", "1234*678"},
+		{"1234567", "*******"},
+		{"123", "***"},
+	}
+
+	for _, tt := range tests {
+		if got := MaskMsisdn(tt.msisdn); got != tt.want {
+			t.Errorf("MaskMsisdn(%q) = %q, want %q", tt.msisdn, got, tt.want)
+		}
+	}
+}
+
+func TestMaskName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", ""},
+		{"John", "J***"},
+		{"John Doe", "J*** D**"},
+		{"  John   Doe ", "J*** D**"},
+		{"A B", "A B"},
+		{"Wanjirũ Kamau", "W****** K****"},
+	}
+
+	for _, tt := range tests {
+		if got := MaskName(tt.name); got != tt.want {
+			t.Errorf("MaskName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPIIAccessMaskPayment(t *testing.T) {
+	tests := []struct {
+		name     string
+		access   *PIIAccess
+		msisdn   string
+		customer string
+	}{
+		{"masked", &PIIAccess{Group: "USER"}, "2547*****678", "J*** D**"},
+		{"unmasked", &PIIAccess{Group: "ADMIN", Unmasked: true}, "254712345678", "John Doe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := &b2c.B2CPayment{
+				Msisdn:                  "254712345678",
+				InitiatorCustomerNames:  "John Doe",
+				ReceiverPartyPublicName: "John Doe",
+			}
+			tt.access.MaskPayment(pb)
+			if pb.Msisdn != tt.msisdn || pb.InitiatorCustomerNames != tt.customer || pb.ReceiverPartyPublicName != tt.customer {
+				t.Errorf("MaskPayment() = %q, %q, %q", pb.Msisdn, pb.InitiatorCustomerNames, pb.ReceiverPartyPublicName)
+			}
+		})
+	}
+}
+
+func TestPIIPolicyUnmasked(t *testing.T) {
+	policy := &PIIPolicy{UnmaskedGroups: []string{"SUPPORT"}}
+
+	tests := []struct {
+		group string
+		want  bool
+	}{
+		{"", false},
+		{"SUPPORT", true},
+		{"USER", false},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Unmasked(tt.group); got != tt.want {
+			t.Errorf("Unmasked(%q) = %v, want %v", tt.group, got, tt.want)
+		}
+	}
+
+	if policy.Access(nil).Unmasked {
+		t.Error("callers without a payload must see personal data masked")
+	}
+}
@@ -0,0 +1,112 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// errLockHeld is returned when a lock is held by someone else
+var errLockHeld = errors.New("lock is held by another holder")
+
+// lockRenewScript extends a lock only when it is still held by the caller
+var lockRenewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// lockReleaseScript deletes a lock only when it is still held by the caller
+var lockReleaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// redisLock is a lock in redis held under a value unique to its holder
+type redisLock struct {
+	redisDB *redis.Client
+	key     string
+	holder  string
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// acquireRedisLock takes the lock at key or fails with errLockHeld.
+//
+// The lock expires after ttl and is renewed until released so that long work keeps it. The returned context is
+// cancelled when the lock is lost, which stops work that is no longer protected by it.
+func acquireRedisLock(
+	ctx context.Context, redisDB *redis.Client, key string, ttl time.Duration,
+) (*redisLock, context.Context, error) {
+	holder, err := randomHex(16)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ok, err := redisDB.SetNX(ctx, key, holder, ttl).Result()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, errLockHeld
+	}
+
+	lockCtx, cancel := context.WithCancel(ctx)
+
+	lock := &redisLock{
+		redisDB: redisDB,
+		key:     key,
+		holder:  holder,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+
+	go lock.renew(lockCtx, ttl)
+
+	return lock, lockCtx, nil
+}
+
+func (lock *redisLock) renew(ctx context.Context, ttl time.Duration) {
+	defer close(lock.done)
+
+	ticker := time.NewTicker(ttl / 3)
+	defer ticker.Stop()
+
+	renewedAt := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		start := time.Now()
+
+		res, err := lockRenewScript.Run(ctx, lock.redisDB, []string{lock.key}, lock.holder, ttl.Milliseconds()).Int64()
+		switch {
+		case err == nil && res == 1:
+			renewedAt = start
+		case err == nil, time.Since(renewedAt) >= ttl:
+			// Taken over or expired
+			lock.cancel()
+			return
+		}
+	}
+}
+
+// release stops renewing the lock and deletes it if still held
+func (lock *redisLock) release() {
+	lock.cancel()
+	<-lock.done
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	_ = lockReleaseScript.Run(ctx, lock.redisDB, []string{lock.key}, lock.holder).Err()
+}
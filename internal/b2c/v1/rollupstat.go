@@ -0,0 +1,457 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	hourlyStatsTable  = "b2c_hourly_stats"
+	monthlyStatsTable = "b2c_monthly_stats"
+
+	hourFormat  = "2006-01-02T15:00"
+	monthFormat = "2006-01"
+
+	// DefaultHourlyStatRetention is how long hourly statistics are kept when no retention is configured
+	DefaultHourlyStatRetention = 90 * 24 * time.Hour
+)
+
+// HourlyStat contains statistics for an hour
+type HourlyStat struct {
+	ID                     uint      `gorm:"primaryKey;autoIncrement"`
+	OrgShortCode           string    `gorm:"index;type:varchar(20);not null"`
+	Date                   string    `gorm:"index;type:varchar(10);not null"`
+	Hour                   string    `gorm:"index;type:varchar(16);not null"`
+	StartTime              time.Time `gorm:"index;precision:6;not null"`
+	TotalTransactions      int64
+	SuccessfulTransactions int64
+	FailedTransactions     int64
+	TotalAmountTransacted  float32
+	TotalCharges           float32
+	CreatedAt              time.Time `gorm:"autoCreateTime"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName ...
+func (*HourlyStat) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), hourlyStatsTable)
+	}
+	return hourlyStatsTable
+}
+
+// MonthlyStat contains statistics for a month rolled up from daily statistics
+type MonthlyStat struct {
+	ID                     uint      `gorm:"primaryKey;autoIncrement"`
+	OrgShortCode           string    `gorm:"index;type:varchar(20);not null"`
+	Month                  string    `gorm:"index;type:varchar(7);not null"`
+	StartTime              time.Time `gorm:"index;precision:6;not null"`
+	TotalTransactions      int64
+	SuccessfulTransactions int64
+	FailedTransactions     int64
+	TotalAmountTransacted  float32
+	TotalCharges           float32
+	CreatedAt              time.Time `gorm:"autoCreateTime"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime"`
+}
+
+// TableName ...
+func (*MonthlyStat) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), monthlyStatsTable)
+	}
+	return monthlyStatsTable
+}
+
+// HourlyStatProto gets hourly statistics protobuf from model
+func HourlyStatProto(db *HourlyStat) *b2c.HourlyStat {
+	return &b2c.HourlyStat{
+		StatId:                 fmt.Sprint(db.ID),
+		Hour:                   db.Hour,
+		OrgShortCode:           db.OrgShortCode,
+		TotalTransactions:      db.TotalTransactions,
+		SuccessfulTransactions: db.SuccessfulTransactions,
+		FailedTransactions:     db.FailedTransactions,
+		TotalAmountTransacted:  db.TotalAmountTransacted,
+		TotalCharges:           db.TotalCharges,
+		StartTimeSeconds:       db.StartTime.Unix(),
+		CreateTimeSeconds:      db.CreatedAt.Unix(),
+		UpdateTimeSeconds:      db.UpdatedAt.Unix(),
+	}
+}
+
+// MonthlyStatProto gets monthly statistics protobuf from model
+func MonthlyStatProto(db *MonthlyStat) *b2c.MonthlyStat {
+	return &b2c.MonthlyStat{
+		StatId:                 fmt.Sprint(db.ID),
+		Month:                  db.Month,
+		OrgShortCode:           db.OrgShortCode,
+		TotalTransactions:      db.TotalTransactions,
+		SuccessfulTransactions: db.SuccessfulTransactions,
+		FailedTransactions:     db.FailedTransactions,
+		TotalAmountTransacted:  db.TotalAmountTransacted,
+		TotalCharges:           db.TotalCharges,
+		StartTimeSeconds:       db.StartTime.Unix(),
+		CreateTimeSeconds:      db.CreatedAt.Unix(),
+		UpdateTimeSeconds:      db.UpdatedAt.Unix(),
+	}
+}
+
+// statTotals are statistics computed for a short code over a period
+type statTotals struct {
+	OrgShortCode           string
+	TotalTransactions      int64
+	SuccessfulTransactions int64
+	TotalAmountTransacted  float64
+	TotalCharges           float64
+}
+
+func (b2cAPI *b2cAPIServer) hourlyStatWorker(ctx context.Context, dur time.Duration) {
+	ticker := time.NewTicker(dur)
+	defer ticker.Stop()
+
+	b2cAPI.heartbeat(workerHourlyStats, dur)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b2cAPI.heartbeat(workerHourlyStats, dur)
+
+			runCtx, ok := b2cAPI.leaderRun(ctx, workerHourlyStats)
+			if !ok {
+				continue
+			}
+
+			currHour := timeutil.HourStart(time.Now())
+
+			// The previous hour may have received results after it was last generated
+			err := b2cAPI.generateHourlyStatistics(runCtx, currHour.Add(-time.Hour))
+			if err == nil {
+				err = b2cAPI.generateHourlyStatistics(runCtx, currHour)
+			}
+			metrics.ObserveStatsWorkerRun(metrics.WorkerHourlyStats, err)
+
+			b2cAPI.deleteExpiredHourlyStats(runCtx)
+		}
+	}
+}
+
+func (b2cAPI *b2cAPIServer) generateHourlyStatistics(
+	ctx context.Context, startTime time.Time, orgShortCodes ...string,
+) error {
+	endTime := startTime.Add(time.Hour)
+	hour := startTime.Format(hourFormat)
+
+	totals := make([]*statTotals, 0)
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Model(&Payment{})
+	if len(orgShortCodes) > 0 {
+		db = db.Where("org_short_code IN(?)", orgShortCodes)
+	}
+
+	err := db.
+		Select(
+			"org_short_code, COUNT(*) AS total_transactions, "+
+				"SUM(CASE WHEN succeeded = 'YES' THEN 1 ELSE 0 END) AS successful_transactions, "+
+				"COALESCE(SUM(transaction_amount), 0) AS total_amount_transacted, "+
+				"COALESCE(SUM(system_charges), 0) AS total_charges",
+		).
+		Where("transaction_time >= ? AND transaction_time < ?", startTime, endTime).
+		Group("org_short_code").
+		Scan(&totals).Error
+	if err != nil {
+		b2cAPI.Logger.Errorf("WORKER: failed to compute statistics for hour [%s]: %v", hour, err)
+		return err
+	}
+
+	for _, total := range totals {
+		statDB := &HourlyStat{
+			OrgShortCode:           total.OrgShortCode,
+			Date:                   startTime.Format("2006-01-02"),
+			Hour:                   hour,
+			StartTime:              startTime,
+			TotalTransactions:      total.TotalTransactions,
+			SuccessfulTransactions: total.SuccessfulTransactions,
+			FailedTransactions:     total.TotalTransactions - total.SuccessfulTransactions,
+			TotalAmountTransacted:  float32(total.TotalAmountTransacted),
+			TotalCharges:           float32(total.TotalCharges),
+		}
+
+		err = b2cAPI.fencedTransaction(ctx, func(tx *gorm.DB) error {
+			statDB2 := &HourlyStat{}
+			err := tx.First(statDB2, "org_short_code = ? AND hour = ?", total.OrgShortCode, hour).Error
+			switch {
+			case err == nil:
+				return tx.Model(statDB2).Select("*").Omit("id", "created_at").Updates(statDB).Error
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return tx.Create(statDB).Error
+			default:
+				return err
+			}
+		})
+		if err != nil {
+			b2cAPI.Logger.Errorf(
+				"WORKER: failed to save stats for hour [%s] org_short_code [%s]: %v", hour, total.OrgShortCode, err,
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+func (b2cAPI *b2cAPIServer) deleteExpiredHourlyStats(ctx context.Context) {
+	retention := b2cAPI.HourlyStatRetention
+	if retention <= 0 {
+		retention = DefaultHourlyStatRetention
+	}
+
+	var deleted int64
+	err := b2cAPI.fencedTransaction(ctx, func(tx *gorm.DB) error {
+		res := tx.Where("start_time < ?", time.Now().UTC().Add(-retention)).Delete(&HourlyStat{})
+		deleted = res.RowsAffected
+		return res.Error
+	})
+	if err != nil {
+		b2cAPI.Logger.Errorf("WORKER: failed to delete expired hourly stats: %v", err)
+		return
+	}
+	if deleted > 0 {
+		b2cAPI.Logger.Infof("WORKER: deleted %d expired hourly stats", deleted)
+	}
+}
+
+// generateMonthlyStatistics rolls up the daily statistics of the month containing t
+func (b2cAPI *b2cAPIServer) generateMonthlyStatistics(
+	ctx context.Context, t time.Time, orgShortCodes ...string,
+) error {
+	startTime := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	month := startTime.Format(monthFormat)
+
+	totals := make([]*statTotals, 0)
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Model(&DailyStat{})
+	if len(orgShortCodes) > 0 {
+		db = db.Where("org_short_code IN(?)", orgShortCodes)
+	}
+
+	err := db.
+		Select(
+			"org_short_code, COALESCE(SUM(total_transactions), 0) AS total_transactions, "+
+				"COALESCE(SUM(successful_transactions), 0) AS successful_transactions, "+
+				"COALESCE(SUM(total_amount_transacted), 0) AS total_amount_transacted, "+
+				"COALESCE(SUM(total_charges), 0) AS total_charges",
+		).
+		Where("date LIKE ?", month+"-%").
+		Group("org_short_code").
+		Scan(&totals).Error
+	if err != nil {
+		b2cAPI.Logger.Errorf("WORKER: failed to roll up statistics for month [%s]: %v", month, err)
+		return err
+	}
+
+	for _, total := range totals {
+		statDB := &MonthlyStat{
+			OrgShortCode:           total.OrgShortCode,
+			Month:                  month,
+			StartTime:              startTime,
+			TotalTransactions:      total.TotalTransactions,
+			SuccessfulTransactions: total.SuccessfulTransactions,
+			FailedTransactions:     total.TotalTransactions - total.SuccessfulTransactions,
+			TotalAmountTransacted:  float32(total.TotalAmountTransacted),
+			TotalCharges:           float32(total.TotalCharges),
+		}
+
+		err = b2cAPI.fencedTransaction(ctx, func(tx *gorm.DB) error {
+			statDB2 := &MonthlyStat{}
+			err := tx.First(statDB2, "org_short_code = ? AND month = ?", total.OrgShortCode, month).Error
+			switch {
+			case err == nil:
+				return tx.Model(statDB2).Select("*").Omit("id", "created_at").Updates(statDB).Error
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return tx.Create(statDB).Error
+			default:
+				return err
+			}
+		})
+		if err != nil {
+			b2cAPI.Logger.Errorf(
+				"WORKER: failed to save stats for month [%s] org_short_code [%s]: %v", month, total.OrgShortCode, err,
+			)
+			return err
+		}
+	}
+	return nil
+}
+
+// reads the id encoded in a stats page token
+func parseStatPageToken(pageToken string) (uint, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	bs, err := base64.StdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+	}
+	v, err := strconv.ParseUint(string(bs), 10, 64)
+	if err != nil {
+		return 0, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+	}
+	return uint(v), nil
+}
+
+// filterStats applies the stats filter; periods are the hours, dates or months the filter dates map to
+func filterStats(db *gorm.DB, filter *b2c.ListStatsFilter, periodColumn string, periods []string) *gorm.DB {
+	if len(filter.GetOrganizationShortCodes()) > 0 {
+		db = db.Where("org_short_code IN(?)", filter.GetOrganizationShortCodes())
+	}
+	if filter.GetStartTimeSeconds() < filter.GetEndTimeSeconds() {
+		db = db.Where(
+			"start_time BETWEEN ? AND ?", time.Unix(filter.GetStartTimeSeconds(), 0), time.Unix(filter.GetEndTimeSeconds(), 0),
+		)
+	} else if len(periods) > 0 {
+		db = db.Where(periodColumn+" IN (?)", periods)
+	}
+	return db
+}
+
+func (b2cAPI *b2cAPIServer) ListHourlyStats(
+	ctx context.Context, req *b2c.ListHourlyStatsRequest,
+) (*b2c.ListHourlyStatsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	ID, err := parseStatPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	db := b2cAPI.SQLDB.Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	// Hourly stats are filtered by the day they fall in
+	db = filterStats(db, req.GetFilter(), "date", req.GetFilter().GetTxDates())
+
+	db, err = scopeStats(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*HourlyStat, 0, pageSize+1)
+
+	err = db.Find(&stats).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list hourly stats")
+	}
+
+	pbs := make([]*b2c.HourlyStat, 0, len(stats))
+
+	for i, stat := range stats {
+		if i == int(pageSize) {
+			break
+		}
+		pbs = append(pbs, HourlyStatProto(stat))
+		ID = stat.ID
+	}
+
+	var token string
+	if len(stats) > int(pageSize) {
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(ID)))
+	}
+
+	return &b2c.ListHourlyStatsResponse{
+		Stats:         pbs,
+		NextPageToken: token,
+	}, nil
+}
+
+func (b2cAPI *b2cAPIServer) ListMonthlyStats(
+	ctx context.Context, req *b2c.ListMonthlyStatsRequest,
+) (*b2c.ListMonthlyStatsResponse, error) {
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	ID, err := parseStatPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	db := b2cAPI.SQLDB.Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	// Monthly stats are filtered by the month the dates fall in
+	months := make([]string, 0, len(req.GetFilter().GetTxDates()))
+	for _, txDate := range req.GetFilter().GetTxDates() {
+		if len(txDate) >= len(monthFormat) {
+			months = append(months, txDate[:len(monthFormat)])
+		}
+	}
+	db = filterStats(db, req.GetFilter(), "month", months)
+
+	db, err = scopeStats(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*MonthlyStat, 0, pageSize+1)
+
+	err = db.Find(&stats).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to list monthly stats")
+	}
+
+	pbs := make([]*b2c.MonthlyStat, 0, len(stats))
+
+	for i, stat := range stats {
+		if i == int(pageSize) {
+			break
+		}
+		pbs = append(pbs, MonthlyStatProto(stat))
+		ID = stat.ID
+	}
+
+	var token string
+	if len(stats) > int(pageSize) {
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(ID)))
+	}
+
+	return &b2c.ListMonthlyStatsResponse{
+		Stats:         pbs,
+		NextPageToken: token,
+	}, nil
+}
@@ -0,0 +1,652 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	schemaMigrationsTable = "b2c_schema_migrations"
+
+	schemaLockKey = "migrationlock:schema"
+	schemaLockTTL = 30 * time.Second
+	// schemaLockWait is how long a replica waits for another to finish migrating
+	schemaLockWait = 10 * time.Minute
+)
+
+// SchemaMigration records a schema migration applied to the database
+type SchemaMigration struct {
+	Version   int64     `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"type:varchar(100);not null"`
+	AppliedAt time.Time `gorm:"precision:6;not null"`
+}
+
+// TableName is table name for model
+func (*SchemaMigration) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), schemaMigrationsTable)
+	}
+	return schemaMigrationsTable
+}
+
+// schemaMigration changes the schema from the previous version; up and down run in a transaction
+// on databases with transactional DDL.
+type schemaMigration struct {
+	version int64
+	name    string
+	up      func(tx *gorm.DB) error
+	down    func(tx *gorm.DB) error
+}
+
+// schemaMigrations are applied in order of version; never edit a released migration, add a new one.
+// Migrations use the frozen models of schemamodel.go rather than the models of the service.
+//
+// The first migrations create tables that may already exist from before migrations were versioned,
+// so they only add what is missing.
+var schemaMigrations = []*schemaMigration{
+	{
+		version: 1,
+		name:    "create_payments",
+		up:      ensureTables(&paymentV1{}),
+		down:    dropTables(&paymentV1{}),
+	},
+	{
+		version: 2,
+		name:    "create_stats",
+		up:      ensureTables(&dailyStatV2{}, &hourlyStatV2{}, &monthlyStatV2{}),
+		down:    dropTables(&dailyStatV2{}, &hourlyStatV2{}, &monthlyStatV2{}),
+	},
+	{
+		version: 3,
+		name:    "create_stats_jobs",
+		up:      ensureTables(&statsJobV3{}),
+		down:    dropTables(&statsJobV3{}),
+	},
+	{
+		version: 4,
+		name:    "create_webhooks",
+		up:      ensureTables(&webhookSubscriptionV4{}, &webhookDeliveryV4{}),
+		down:    dropTables(&webhookSubscriptionV4{}, &webhookDeliveryV4{}),
+	},
+	{
+		version: 5,
+		name:    "create_data_migrations",
+		up:      ensureTables(&dataMigrationV5{}),
+		down:    dropTables(&dataMigrationV5{}),
+	},
+	{
+		version: 6,
+		name:    "create_worker_fences",
+		up:      ensureTables(&workerFenceV6{}),
+		down:    dropTables(&workerFenceV6{}),
+	},
+	{
+		// Payments created on mysql before the schema was portable use enums for yes/no columns
+		version: 7,
+		name:    "payments_yes_no_varchar",
+		up: func(tx *gorm.DB) error {
+			if tx.Dialector.Name() != "mysql" {
+				return nil
+			}
+			return tx.Exec(
+				"ALTER TABLE ? MODIFY COLUMN succeeded varchar(7) DEFAULT 'NO', MODIFY COLUMN processed varchar(3) DEFAULT 'NO'",
+				clause.Table{Name: (&Payment{}).TableName()},
+			).Error
+		},
+		down: func(tx *gorm.DB) error {
+			if tx.Dialector.Name() != "mysql" {
+				return nil
+			}
+			return tx.Exec(
+				"ALTER TABLE ? MODIFY COLUMN succeeded enum('YES','NO','UNKNOWN') DEFAULT 'NO', MODIFY COLUMN processed enum('YES','NO') DEFAULT 'NO'",
+				clause.Table{Name: (&Payment{}).TableName()},
+			).Error
+		},
+	},
+	{
+		version: 8,
+		name:    "create_payment_archive",
+		up:      ensureTables(&archivedPaymentV8{}, &archivedPaymentFileV8{}, &archivalRunV8{}),
+		down:    dropTables(&archivedPaymentV8{}, &archivedPaymentFileV8{}, &archivalRunV8{}),
+	},
+	{
+		// Personal data columns hold ciphertexts and msisdns are looked up through a blind index.
+		// Reverting keeps the wider columns as they may hold ciphertexts.
+		version: 9,
+		name:    "payments_pii_encryption",
+		up:      encryptedPaymentColumnsUp,
+		down:    encryptedPaymentColumnsDown,
+	},
+	{
+		version: 10,
+		name:    "create_pii_access_logs",
+		up: func(tx *gorm.DB) error {
+			for _, model := range []tabler{&paymentInitiatorGroupV10{}, &archivedPaymentInitiatorGroupV10{}} {
+				err := addMissingColumns(tx, model, "InitiatorGroup")
+				if err != nil {
+					return err
+				}
+			}
+			return ensureTables(&piiAccessLogV10{})(tx)
+		},
+		down: func(tx *gorm.DB) error {
+			for _, model := range []tabler{&paymentInitiatorGroupV10{}, &archivedPaymentInitiatorGroupV10{}} {
+				if tx.Migrator().HasColumn(model, "InitiatorGroup") {
+					err := tx.Migrator().DropColumn(model, "InitiatorGroup")
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return dropTables(&piiAccessLogV10{})(tx)
+		},
+	},
+	{
+		version: 11,
+		name:    "create_api_keys",
+		up:      ensureTables(&apiKeyV11{}),
+		down:    dropTables(&apiKeyV11{}),
+	},
+	{
+		// Mpesa charges used to hold the balance of the charges paid account which is moved to its own column.
+		// Charges of earlier payments are unknown and left at zero.
+		version: 12,
+		name:    "add_charges_paid_account_funds",
+		up: func(tx *gorm.DB) error {
+			for _, model := range []tabler{&paymentChargesPaidV12{}, &archivedPaymentChargesPaidV12{}} {
+				err := addMissingColumns(tx, model, "ChargesPaidAccountFunds")
+				if err != nil {
+					return err
+				}
+				err = tx.Table(model.TableName()).Where("mpesa_charges <> 0").
+					Update("charges_paid_account_funds", gorm.Expr("mpesa_charges")).Error
+				if err != nil {
+					return err
+				}
+				err = tx.Table(model.TableName()).Where("mpesa_charges <> 0").Update("mpesa_charges", 0).Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		down: func(tx *gorm.DB) error {
+			for _, model := range []tabler{&paymentChargesPaidV12{}, &archivedPaymentChargesPaidV12{}} {
+				if !tx.Migrator().HasColumn(model, "ChargesPaidAccountFunds") {
+					continue
+				}
+				err := tx.Table(model.TableName()).Where("charges_paid_account_funds <> 0").
+					Update("mpesa_charges", gorm.Expr("charges_paid_account_funds")).Error
+				if err != nil {
+					return err
+				}
+				err = tx.Migrator().DropColumn(model, "ChargesPaidAccountFunds")
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+	{
+		// Reversals are pending until their result is received
+		version: 13,
+		name:    "add_reversal_conversation_id",
+		up: func(tx *gorm.DB) error {
+			for _, model := range []tabler{&paymentReversalV13{}, &archivedPaymentReversalV13{}} {
+				err := addMissingColumns(tx, model, "ReversalConversationID")
+				if err != nil {
+					return err
+				}
+				if !tx.Migrator().HasIndex(model, "ReversalConversationID") {
+					err = tx.Migrator().CreateIndex(model, "ReversalConversationID")
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+		down: func(tx *gorm.DB) error {
+			for _, model := range []tabler{&paymentReversalV13{}, &archivedPaymentReversalV13{}} {
+				if tx.Migrator().HasColumn(model, "ReversalConversationID") {
+					err := tx.Migrator().DropColumn(model, "ReversalConversationID")
+					if err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	},
+	{
+		// Running stats jobs are judged stale by their heartbeat instead of their last update
+		version: 14,
+		name:    "add_stats_job_heartbeat",
+		up: func(tx *gorm.DB) error {
+			return addMissingColumns(tx, &statsJobHeartbeatV14{}, "HeartbeatAt")
+		},
+		down: func(tx *gorm.DB) error {
+			if !tx.Migrator().HasColumn(&statsJobHeartbeatV14{}, "HeartbeatAt") {
+				return nil
+			}
+			return tx.Migrator().DropColumn(&statsJobHeartbeatV14{}, "HeartbeatAt")
+		},
+	},
+	{
+		// Payments created on mysql before the schema was portable use float(10) and tinyint(1) columns
+		// while tables created by migrations use the types of their dialect; both get the same explicit types.
+		version: 15,
+		name:    "payments_portable_column_types",
+		up:      portablePaymentColumnsUp,
+		down:    portablePaymentColumnsDown,
+	},
+	{
+		// Webhook secrets are encrypted; existing secrets are encrypted by the keyring reencrypt command.
+		// Reverting keeps the wider column as it may hold ciphertexts.
+		version: 16,
+		name:    "webhook_secret_encryption",
+		up: func(tx *gorm.DB) error {
+			// Column lengths are not enforced by sqlite
+			if tx.Dialector.Name() == "sqlite" {
+				return nil
+			}
+			return tx.Migrator().AlterColumn(&webhookSecretV16{}, "Secret")
+		},
+		down: func(*gorm.DB) error {
+			return nil
+		},
+	},
+}
+
+// portableColumn is a payment column with its type on each dialect and its type before the schema was portable
+type portableColumn struct {
+	model    interface{}
+	name     string
+	mysql    string
+	postgres string
+	legacy   string
+}
+
+// portablePaymentColumns are the payment columns whose types were mysql only.
+//
+// Sqlite is left out as its column types are affinities that cannot be altered; migrations already created
+// real and numeric columns there.
+var portablePaymentColumns = []*portableColumn{
+	{model: &paymentV1{}, name: "transaction_amount", mysql: "float", postgres: "real", legacy: "float(10)"},
+	{model: &paymentV1{}, name: "working_account_funds", mysql: "float", postgres: "real", legacy: "float(10)"},
+	{model: &paymentV1{}, name: "utility_account_funds", mysql: "float", postgres: "real", legacy: "float(10)"},
+	{model: &paymentChargesPaidV12{}, name: "charges_paid_account_funds", mysql: "float", postgres: "real"},
+	{model: &paymentV1{}, name: "mpesa_charges", mysql: "float", postgres: "real", legacy: "float(10)"},
+	{model: &paymentV1{}, name: "system_charges", mysql: "float", postgres: "real", legacy: "float(10)"},
+	{model: &paymentV1{}, name: "recipient_registered", mysql: "boolean", postgres: "boolean", legacy: "tinyint(1)"},
+	{model: &paymentV1{}, name: "publish", mysql: "boolean", postgres: "boolean", legacy: "tinyint(1)"},
+}
+
+func portablePaymentColumnsUp(tx *gorm.DB) error {
+	for _, table := range []string{(&Payment{}).TableName(), (&ArchivedPayment{}).TableName()} {
+		for _, col := range portablePaymentColumns {
+			var err error
+			switch tx.Dialector.Name() {
+			case "mysql":
+				err = modifyMySQLColumn(tx, table, col, col.mysql)
+			case "postgres":
+				err = tx.Exec(
+					"ALTER TABLE ? ALTER COLUMN ? TYPE "+col.postgres+" USING ?::"+col.postgres,
+					clause.Table{Name: table}, clause.Column{Name: col.name}, clause.Column{Name: col.name},
+				).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// modifyMySQLColumn changes the type of the column on mysql.
+//
+// MODIFY COLUMN replaces the whole column definition, so the constraints and default of the column in its frozen
+// model are repeated after the type.
+func modifyMySQLColumn(tx *gorm.DB, table string, col *portableColumn, dataType string) error {
+	stmt := &gorm.Statement{DB: tx}
+	err := stmt.Parse(col.model)
+	if err != nil {
+		return err
+	}
+	field := stmt.Schema.LookUpField(col.name)
+	if field == nil {
+		return fmt.Errorf("failed to look up field with name: %s", col.name)
+	}
+	definition := strings.TrimPrefix(tx.Migrator().FullDataTypeOf(field).SQL, tx.Dialector.DataTypeOf(field))
+	return tx.Exec(
+		"ALTER TABLE ? MODIFY COLUMN ? "+dataType+definition, clause.Table{Name: table}, clause.Column{Name: col.name},
+	).Error
+}
+
+// portablePaymentColumnsDown restores the types that migrations 1 and 8 created; legacy mysql types are
+// restored on payments as they may have been created with them.
+func portablePaymentColumnsDown(tx *gorm.DB) error {
+	for _, table := range []string{(&Payment{}).TableName(), (&ArchivedPayment{}).TableName()} {
+		for _, col := range portablePaymentColumns {
+			var err error
+			switch tx.Dialector.Name() {
+			case "mysql":
+				if table != (&Payment{}).TableName() || col.legacy == "" {
+					continue
+				}
+				err = modifyMySQLColumn(tx, table, col, col.legacy)
+			case "postgres":
+				if col.postgres != "real" {
+					continue
+				}
+				err = tx.Exec(
+					"ALTER TABLE ? ALTER COLUMN ? TYPE decimal", clause.Table{Name: table}, clause.Column{Name: col.name},
+				).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type tabler interface {
+	TableName() string
+}
+
+func msisdnIndexName(model tabler) string {
+	return fmt.Sprintf("idx_%s_msisdn", model.TableName())
+}
+
+func encryptedPaymentColumnsUp(tx *gorm.DB) error {
+	for _, model := range []tabler{&paymentPIIV9{}, &archivedPaymentPIIV9{}} {
+		m := tx.Migrator()
+
+		if !m.HasColumn(model, "MsisdnIndex") {
+			err := m.AddColumn(model, "MsisdnIndex")
+			if err != nil {
+				return err
+			}
+		}
+		if !m.HasIndex(model, "MsisdnIndex") {
+			err := m.CreateIndex(model, "MsisdnIndex")
+			if err != nil {
+				return err
+			}
+		}
+
+		// An index of ciphertexts is of no use
+		if m.HasIndex(model, msisdnIndexName(model)) {
+			err := m.DropIndex(model, msisdnIndexName(model))
+			if err != nil {
+				return err
+			}
+		}
+
+		// Column lengths are not enforced by sqlite
+		if tx.Dialector.Name() == "sqlite" {
+			continue
+		}
+		for _, field := range []string{"Msisdn", "InitiatorCustomerNames", "ReceiverPublicName"} {
+			err := m.AlterColumn(model, field)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func encryptedPaymentColumnsDown(tx *gorm.DB) error {
+	for _, model := range []tabler{&paymentPIIV9{}, &archivedPaymentPIIV9{}} {
+		m := tx.Migrator()
+
+		if m.HasColumn(model, "MsisdnIndex") {
+			err := m.DropColumn(model, "MsisdnIndex")
+			if err != nil {
+				return err
+			}
+		}
+		if !m.HasIndex(model, msisdnIndexName(model)) {
+			err := tx.Exec(
+				"CREATE INDEX ? ON ? (msisdn)",
+				clause.Column{Name: msisdnIndexName(model)}, clause.Table{Name: model.TableName()},
+			).Error
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ensureTables creates the tables or adds the columns missing from them
+func ensureTables(models ...interface{}) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		for _, model := range models {
+			if !tx.Migrator().HasTable(model) {
+				err := tx.Migrator().CreateTable(model)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			stmt := &gorm.Statement{DB: tx}
+			err := stmt.Parse(model)
+			if err != nil {
+				return err
+			}
+
+			fields := make([]string, 0, len(stmt.Schema.Fields))
+			for _, field := range stmt.Schema.Fields {
+				if field.DBName != "" {
+					fields = append(fields, field.Name)
+				}
+			}
+
+			err = addMissingColumns(tx, model, fields...)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func dropTables(models ...interface{}) func(tx *gorm.DB) error {
+	return func(tx *gorm.DB) error {
+		return tx.Migrator().DropTable(models...)
+	}
+}
+
+// SchemaMigrationStatus is whether a schema migration has been applied
+type SchemaMigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// LatestSchemaVersion is the version of the last schema migration
+func LatestSchemaVersion() int64 {
+	return schemaMigrations[len(schemaMigrations)-1].version
+}
+
+func appliedSchemaMigrations(ctx context.Context, sqlDB *gorm.DB) (map[int64]*SchemaMigration, error) {
+	if !sqlDB.WithContext(ctx).Migrator().HasTable(&SchemaMigration{}) {
+		err := sqlDB.WithContext(ctx).Migrator().CreateTable(&SchemaMigration{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create schema migrations table: %v", err)
+		}
+	}
+
+	migrationsDB := make([]*SchemaMigration, 0)
+	err := sqlDB.WithContext(ctx).Find(&migrationsDB).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get applied schema migrations: %v", err)
+	}
+
+	applied := make(map[int64]*SchemaMigration, len(migrationsDB))
+	for _, migrationDB := range migrationsDB {
+		applied[migrationDB.Version] = migrationDB
+	}
+
+	return applied, nil
+}
+
+// SchemaStatus lists the schema migrations and whether they have been applied
+func SchemaStatus(ctx context.Context, sqlDB *gorm.DB) ([]*SchemaMigrationStatus, error) {
+	applied, err := appliedSchemaMigrations(ctx, sqlDB)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]*SchemaMigrationStatus, 0, len(schemaMigrations))
+	for _, m := range schemaMigrations {
+		status := &SchemaMigrationStatus{Version: m.version, Name: m.name}
+		if migrationDB, ok := applied[m.version]; ok {
+			status.Applied = true
+			status.AppliedAt = migrationDB.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// MigrateSchema applies pending schema migrations up to and including the target version; zero applies all.
+//
+// It returns the migrations that were applied.
+func MigrateSchema(ctx context.Context, sqlDB *gorm.DB, target int64) ([]string, error) {
+	applied, err := appliedSchemaMigrations(ctx, sqlDB)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make([]string, 0)
+
+	for _, m := range schemaMigrations {
+		if target > 0 && m.version > target {
+			break
+		}
+		if _, ok := applied[m.version]; ok {
+			continue
+		}
+
+		err = sqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			err := m.up(tx)
+			if err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigration{Version: m.version, Name: m.name, AppliedAt: time.Now().UTC()}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("schema migration %d_%s failed: %v", m.version, m.name, err)
+		}
+
+		done = append(done, fmt.Sprintf("%d_%s", m.version, m.name))
+	}
+
+	return done, nil
+}
+
+// RollbackSchema reverts the last steps applied schema migrations.
+//
+// It returns the migrations that were reverted.
+func RollbackSchema(ctx context.Context, sqlDB *gorm.DB, steps int) ([]string, error) {
+	if steps <= 0 {
+		return nil, errors.New("steps must be positive")
+	}
+
+	applied, err := appliedSchemaMigrations(ctx, sqlDB)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]*schemaMigration, 0, len(applied))
+	for _, m := range schemaMigrations {
+		if _, ok := applied[m.version]; ok {
+			migrations = append(migrations, m)
+		}
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].version > migrations[j].version
+	})
+
+	done := make([]string, 0, steps)
+
+	for i := 0; i < steps && i < len(migrations); i++ {
+		m := migrations[i]
+
+		err = sqlDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			err := m.down(tx)
+			if err != nil {
+				return err
+			}
+			return tx.Delete(&SchemaMigration{}, "version = ?", m.version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("rollback of schema migration %d_%s failed: %v", m.version, m.name, err)
+		}
+
+		done = append(done, fmt.Sprintf("%d_%s", m.version, m.name))
+	}
+
+	return done, nil
+}
+
+// checkSchemaMigrated fails when schema migrations are pending
+func checkSchemaMigrated(ctx context.Context, sqlDB *gorm.DB) error {
+	statuses, err := SchemaStatus(ctx, sqlDB)
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		if !status.Applied {
+			return fmt.Errorf("schema migration %d_%s is pending; run the migrate command", status.Version, status.Name)
+		}
+	}
+	return nil
+}
+
+// migrateSchema applies pending schema migrations while holding a lock so that replicas starting together
+// do not apply the same migration.
+func (b2cAPI *b2cAPIServer) migrateSchema(ctx context.Context) error {
+	deadline := time.Now().Add(schemaLockWait)
+
+	for {
+		lock, lockCtx, err := acquireRedisLock(ctx, b2cAPI.RedisDB, schemaLockKey, schemaLockTTL)
+		switch {
+		case err == nil:
+			defer lock.release()
+
+			applied, err := MigrateSchema(lockCtx, b2cAPI.SQLDB, 0)
+			for _, name := range applied {
+				b2cAPI.Logger.Infof("applied schema migration %s", name)
+			}
+			return err
+		case !errors.Is(err, errLockHeld):
+			return err
+		}
+
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for schema migration lock")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
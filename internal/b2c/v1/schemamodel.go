@@ -0,0 +1,334 @@
+package b2c_app_v1
+
+import (
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// The models below are the tables and columns as each schema migration created them. They are frozen so that a
+// migration creates the same schema whichever release runs it; never change them, change the schema in a new
+// migration instead.
+
+// paymentV1 is the payments table of migration 1
+type paymentV1 struct {
+	ID                            uint   `gorm:"primaryKey;autoIncrement"`
+	InitiatorID                   string `gorm:"index;type:varchar(50)"`
+	InitiatorCustomerReference    string `gorm:"index;type:varchar(50)"`
+	InitiatorCustomerNames        string `gorm:"type:varchar(50)"`
+	InitiatorTransactionReference string `gorm:"index;type:varchar(50)"`
+
+	Msisdn            string  `gorm:"index;type:varchar(15)"`
+	OrgShortCode      string  `gorm:"index;type:varchar(15)"`
+	CommandId         string  `gorm:"index;type:varchar(30)"`
+	TransactionAmount float32 `gorm:"index"`
+
+	ConversationID           string `gorm:"index;type:varchar(50);not null"`
+	OriginatorConversationID string `gorm:"index;type:varchar(50);not null"`
+	ResponseDescription      string `gorm:"type:varchar(300)"`
+	ResponseCode             string `gorm:"index;type:varchar(10)"`
+	ResultCode               string `gorm:"index;type:varchar(10)"`
+	ResultDescription        string `gorm:"type:varchar(300)"`
+
+	WorkingAccountFunds float32
+	UtilityAccountFunds float32
+	MpesaCharges        float32
+	SystemCharges       float32
+	RecipientRegistered bool           `gorm:"index"`
+	MpesaReceiptId      sql.NullString `gorm:"index;type:varchar(50);unique"`
+	ReceiverPublicName  string         `gorm:"type:varchar(50)"`
+
+	B2CStatus string `gorm:"index;type:varchar(30);column:b2c_status"`
+	Source    string `gorm:"index;type:varchar(30)"`
+	Tag       string `gorm:"index;type:varchar(30)"`
+	Succeeded string `gorm:"index;type:varchar(7);default:NO"`
+	Processed string `gorm:"index;type:varchar(3);default:NO"`
+
+	Publish     bool
+	PublishInfo string `gorm:"type:text"`
+	TraceParent string `gorm:"type:varchar(60)"`
+
+	TransactionTime sql.NullTime `gorm:"index;precision:6"`
+	UpdatedAt       time.Time    `gorm:"autoUpdateTime;precision:6"`
+	CreatedAt       time.Time    `gorm:"index;autoCreateTime;precision:6;not null"`
+}
+
+func (*paymentV1) TableName() string { return (&Payment{}).TableName() }
+
+// dailyStatV2 is the daily stats table of migration 2
+type dailyStatV2 struct {
+	ID                     uint   `gorm:"primaryKey;autoIncrement"`
+	OrgShortCode           string `gorm:"index;type:varchar(20);not null"`
+	Date                   string `gorm:"index;type:varchar(10);not null"`
+	TotalTransactions      int32  `gorm:"not null"`
+	SuccessfulTransactions int32
+	FailedTransactions     int32
+	TotalAmountTransacted  float32 `gorm:"index"`
+	TotalCharges           float32 `gorm:"index"`
+	TotalMpesaCharges      float32
+	UniqueRecipients       int64
+	PendingTransactions    int64
+	UnknownTransactions    int64
+	CommandStats           string `gorm:"type:text"`
+	AverageLatencyMillis   int64
+	P95LatencyMillis       int64
+	CreatedAt              time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt              time.Time      `gorm:"autoCreateTime"`
+	DeletedAt              gorm.DeletedAt `gorm:"index"`
+}
+
+func (*dailyStatV2) TableName() string { return (&DailyStat{}).TableName() }
+
+// hourlyStatV2 is the hourly stats table of migration 2
+type hourlyStatV2 struct {
+	ID                     uint      `gorm:"primaryKey;autoIncrement"`
+	OrgShortCode           string    `gorm:"index;type:varchar(20);not null"`
+	Date                   string    `gorm:"index;type:varchar(10);not null"`
+	Hour                   string    `gorm:"index;type:varchar(16);not null"`
+	StartTime              time.Time `gorm:"index;precision:6;not null"`
+	TotalTransactions      int64
+	SuccessfulTransactions int64
+	FailedTransactions     int64
+	TotalAmountTransacted  float32
+	TotalCharges           float32
+	CreatedAt              time.Time `gorm:"autoCreateTime"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime"`
+}
+
+func (*hourlyStatV2) TableName() string { return (&HourlyStat{}).TableName() }
+
+// monthlyStatV2 is the monthly stats table of migration 2
+type monthlyStatV2 struct {
+	ID                     uint      `gorm:"primaryKey;autoIncrement"`
+	OrgShortCode           string    `gorm:"index;type:varchar(20);not null"`
+	Month                  string    `gorm:"index;type:varchar(7);not null"`
+	StartTime              time.Time `gorm:"index;precision:6;not null"`
+	TotalTransactions      int64
+	SuccessfulTransactions int64
+	FailedTransactions     int64
+	TotalAmountTransacted  float32
+	TotalCharges           float32
+	CreatedAt              time.Time `gorm:"autoCreateTime"`
+	UpdatedAt              time.Time `gorm:"autoUpdateTime"`
+}
+
+func (*monthlyStatV2) TableName() string { return (&MonthlyStat{}).TableName() }
+
+// statsJobV3 is the stats jobs table of migration 3
+type statsJobV3 struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	StartDate   string `gorm:"type:varchar(10);not null"`
+	EndDate     string `gorm:"type:varchar(10);not null"`
+	ShortCodes  string `gorm:"type:varchar(500)"`
+	SkipHourly  bool
+	Status      string `gorm:"index;type:varchar(30)"`
+	DaysTotal   int32
+	DaysDone    int32
+	Error       string       `gorm:"type:varchar(300)"`
+	RequestedBy string       `gorm:"type:varchar(50)"`
+	StartedAt   sql.NullTime `gorm:"precision:6"`
+	EndedAt     sql.NullTime `gorm:"precision:6"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime;precision:6;not null"`
+	UpdatedAt   time.Time    `gorm:"autoUpdateTime;precision:6"`
+}
+
+func (*statsJobV3) TableName() string { return (&StatsJob{}).TableName() }
+
+// webhookSubscriptionV4 is the webhook subscriptions table of migration 4
+type webhookSubscriptionV4 struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement"`
+	InitiatorID string         `gorm:"index;type:varchar(50);not null"`
+	URL         string         `gorm:"type:varchar(500);not null"`
+	Secret      string         `gorm:"type:varchar(100);not null"`
+	EventTypes  string         `gorm:"type:varchar(300)"`
+	Description string         `gorm:"type:varchar(300)"`
+	Active      bool           `gorm:"index"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+func (*webhookSubscriptionV4) TableName() string { return (&WebhookSubscription{}).TableName() }
+
+// webhookDeliveryV4 is the webhook deliveries table of migration 4
+type webhookDeliveryV4 struct {
+	ID             uint   `gorm:"primaryKey;autoIncrement"`
+	SubscriptionID uint   `gorm:"index;not null"`
+	InitiatorID    string `gorm:"index;type:varchar(50)"`
+	TransactionID  uint   `gorm:"index"`
+	EventType      string `gorm:"index;type:varchar(50)"`
+	URL            string `gorm:"type:varchar(500);not null"`
+	Payload        string `gorm:"type:text"`
+	Status         string `gorm:"index;type:varchar(50)"`
+	Attempts       int32
+	LastStatusCode int32
+	LastError      string       `gorm:"type:varchar(300)"`
+	NextAttemptAt  time.Time    `gorm:"index;precision:6"`
+	DeliveredAt    sql.NullTime `gorm:"precision:6"`
+	CreatedAt      time.Time    `gorm:"index;autoCreateTime;precision:6;not null"`
+	UpdatedAt      time.Time    `gorm:"autoUpdateTime;precision:6"`
+}
+
+func (*webhookDeliveryV4) TableName() string { return (&WebhookDelivery{}).TableName() }
+
+// dataMigrationV5 is the data migrations table of migration 5
+type dataMigrationV5 struct {
+	Name      string `gorm:"primaryKey;type:varchar(100)"`
+	LastID    uint   `gorm:"not null"`
+	Done      bool
+	CreatedAt time.Time `gorm:"autoCreateTime;precision:6;not null"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;precision:6"`
+}
+
+func (*dataMigrationV5) TableName() string { return (&DataMigration{}).TableName() }
+
+// workerFenceV6 is the worker fences table of migration 6
+type workerFenceV6 struct {
+	Worker    string    `gorm:"primaryKey;type:varchar(50)"`
+	Token     int64     `gorm:"not null"`
+	Holder    string    `gorm:"type:varchar(100)"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;precision:6"`
+}
+
+func (*workerFenceV6) TableName() string { return (&WorkerFence{}).TableName() }
+
+// archivedPaymentV8 is the archived payments table of migration 8
+type archivedPaymentV8 struct {
+	Payment    paymentV1 `gorm:"embedded"`
+	RunID      uint      `gorm:"index"`
+	ArchivedAt time.Time `gorm:"precision:6"`
+}
+
+func (*archivedPaymentV8) TableName() string { return (&ArchivedPayment{}).TableName() }
+
+// archivedPaymentFileV8 is the archived payment files table of migration 8
+type archivedPaymentFileV8 struct {
+	PaymentID      uint           `gorm:"primaryKey;autoIncrement:false"`
+	MpesaReceiptId sql.NullString `gorm:"index;type:varchar(50)"`
+	File           string         `gorm:"type:varchar(255);not null"`
+	RunID          uint           `gorm:"index"`
+	ArchivedAt     time.Time      `gorm:"precision:6"`
+}
+
+func (*archivedPaymentFileV8) TableName() string { return (&ArchivedPaymentFile{}).TableName() }
+
+// archivalRunV8 is the archival runs table of migration 8
+type archivalRunV8 struct {
+	ID               uint   `gorm:"primaryKey;autoIncrement"`
+	Status           string `gorm:"index;type:varchar(30)"`
+	Target           string `gorm:"type:varchar(30)"`
+	RetentionMonths  int32
+	CutoffTime       time.Time `gorm:"precision:6;not null"`
+	PaymentsArchived int64
+	File             string       `gorm:"type:varchar(255)"`
+	Error            string       `gorm:"type:varchar(300)"`
+	RequestedBy      string       `gorm:"type:varchar(50)"`
+	StartedAt        time.Time    `gorm:"precision:6;not null"`
+	EndedAt          sql.NullTime `gorm:"precision:6"`
+	UpdatedAt        time.Time    `gorm:"autoUpdateTime;precision:6"`
+}
+
+func (*archivalRunV8) TableName() string { return (&ArchivalRun{}).TableName() }
+
+// paymentPIIV9 are the payment columns of migration 9
+type paymentPIIV9 struct {
+	InitiatorCustomerNames string `gorm:"type:varchar(255)"`
+	Msisdn                 string `gorm:"type:varchar(255)"`
+	MsisdnIndex            string `gorm:"index;type:varchar(64)"`
+	ReceiverPublicName     string `gorm:"type:varchar(255)"`
+}
+
+func (*paymentPIIV9) TableName() string { return (&Payment{}).TableName() }
+
+type archivedPaymentPIIV9 struct {
+	Payment paymentPIIV9 `gorm:"embedded"`
+}
+
+func (*archivedPaymentPIIV9) TableName() string { return (&ArchivedPayment{}).TableName() }
+
+// paymentInitiatorGroupV10 is the payment column of migration 10
+type paymentInitiatorGroupV10 struct {
+	InitiatorGroup string `gorm:"type:varchar(50)"`
+}
+
+func (*paymentInitiatorGroupV10) TableName() string { return (&Payment{}).TableName() }
+
+type archivedPaymentInitiatorGroupV10 struct {
+	Payment paymentInitiatorGroupV10 `gorm:"embedded"`
+}
+
+func (*archivedPaymentInitiatorGroupV10) TableName() string { return (&ArchivedPayment{}).TableName() }
+
+// piiAccessLogV10 is the pii access logs table of migration 10
+type piiAccessLogV10 struct {
+	ID           uint   `gorm:"primaryKey;autoIncrement"`
+	Actor        string `gorm:"index;type:varchar(50)"`
+	ActorGroup   string `gorm:"type:varchar(50)"`
+	Action       string `gorm:"index;type:varchar(50)"`
+	Detail       string `gorm:"type:varchar(255)"`
+	PaymentIDs   string `gorm:"type:text"`
+	PaymentCount int64
+	CreatedAt    time.Time `gorm:"index;autoCreateTime;precision:6;not null"`
+}
+
+func (*piiAccessLogV10) TableName() string { return (&PIIAccessLog{}).TableName() }
+
+// apiKeyV11 is the api keys table of migration 11
+type apiKeyV11 struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	KeyID       string `gorm:"uniqueIndex;type:varchar(32);not null"`
+	SecretHash  string `gorm:"type:varchar(64);not null"`
+	Name        string `gorm:"type:varchar(100);not null"`
+	Group       string `gorm:"column:key_group;type:varchar(50);not null"`
+	RPCs        string `gorm:"column:rpcs;type:text;not null"`
+	ShortCodes  string `gorm:"type:text"`
+	InitiatorID string `gorm:"index;type:varchar(50)"`
+	CreatedBy   string `gorm:"type:varchar(50)"`
+	ExpiresAt   sql.NullTime
+	LastUsedAt  sql.NullTime
+	RevokedAt   sql.NullTime `gorm:"index"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime"`
+}
+
+func (*apiKeyV11) TableName() string { return (&APIKey{}).TableName() }
+
+// paymentChargesPaidV12 is the payment column of migration 12
+type paymentChargesPaidV12 struct {
+	ChargesPaidAccountFunds float32
+}
+
+func (*paymentChargesPaidV12) TableName() string { return (&Payment{}).TableName() }
+
+type archivedPaymentChargesPaidV12 struct {
+	Payment paymentChargesPaidV12 `gorm:"embedded"`
+}
+
+func (*archivedPaymentChargesPaidV12) TableName() string { return (&ArchivedPayment{}).TableName() }
+
+// paymentReversalV13 is the payment column of migration 13
+type paymentReversalV13 struct {
+	ReversalConversationID string `gorm:"index;type:varchar(50)"`
+}
+
+func (*paymentReversalV13) TableName() string { return (&Payment{}).TableName() }
+
+type archivedPaymentReversalV13 struct {
+	Payment paymentReversalV13 `gorm:"embedded"`
+}
+
+func (*archivedPaymentReversalV13) TableName() string { return (&ArchivedPayment{}).TableName() }
+
+// statsJobHeartbeatV14 is the stats job column of migration 14
+type statsJobHeartbeatV14 struct {
+	HeartbeatAt sql.NullTime `gorm:"precision:6"`
+}
+
+func (*statsJobHeartbeatV14) TableName() string { return (&StatsJob{}).TableName() }
+
+// webhookSecretV16 is the webhook subscription column of migration 16
+type webhookSecretV16 struct {
+	Secret string `gorm:"type:varchar(500);not null"`
+}
+
+func (*webhookSecretV16) TableName() string { return (&WebhookSubscription{}).TableName() }
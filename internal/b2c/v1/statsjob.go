@@ -0,0 +1,335 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	statsJobsTable = "b2c_stats_jobs"
+
+	// maximum number of days a single job can regenerate
+	maxStatsJobDays = 366
+
+	// how often a running job records that it is alive
+	statsJobHeartbeatInterval = time.Minute
+
+	// jobs without a heartbeat within this duration are reported as failed; the instance running them has likely stopped
+	statsJobStaleAfter = 5 * statsJobHeartbeatInterval
+)
+
+// StatsJob is a background job recomputing statistics for a date range
+type StatsJob struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	StartDate   string `gorm:"type:varchar(10);not null"`
+	EndDate     string `gorm:"type:varchar(10);not null"`
+	ShortCodes  string `gorm:"type:varchar(500)"`
+	SkipHourly  bool
+	Status      string `gorm:"index;type:varchar(30)"`
+	DaysTotal   int32
+	DaysDone    int32
+	Error       string       `gorm:"type:varchar(300)"`
+	RequestedBy string       `gorm:"type:varchar(50)"`
+	StartedAt   sql.NullTime `gorm:"precision:6"`
+	EndedAt     sql.NullTime `gorm:"precision:6"`
+	HeartbeatAt sql.NullTime `gorm:"precision:6"`
+	CreatedAt   time.Time    `gorm:"autoCreateTime;precision:6;not null"`
+	UpdatedAt   time.Time    `gorm:"autoUpdateTime;precision:6"`
+}
+
+// TableName is table name for model
+func (*StatsJob) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), statsJobsTable)
+	}
+	return statsJobsTable
+}
+
+func (db *StatsJob) shortCodes() []string {
+	if db.ShortCodes == "" {
+		return nil
+	}
+	return strings.Split(db.ShortCodes, ",")
+}
+
+// lastAlive is when the job was last known to be running; jobs that have not started count from their creation
+func (db *StatsJob) lastAlive() time.Time {
+	if db.HeartbeatAt.Valid {
+		return db.HeartbeatAt.Time
+	}
+	return db.CreatedAt
+}
+
+// StatsJobProto gets stats job protobuf from model
+func StatsJobProto(db *StatsJob) *b2c.StatsJob {
+	pb := &b2c.StatsJob{
+		JobId:             fmt.Sprint(db.ID),
+		StartDate:         db.StartDate,
+		EndDate:           db.EndDate,
+		ShortCodes:        db.shortCodes(),
+		SkipHourly:        db.SkipHourly,
+		Status:            b2c.StatsJobStatus(b2c.StatsJobStatus_value[db.Status]),
+		DaysTotal:         db.DaysTotal,
+		DaysDone:          db.DaysDone,
+		Error:             db.Error,
+		RequestedBy:       db.RequestedBy,
+		CreateTimeSeconds: db.CreatedAt.Unix(),
+		UpdateTimeSeconds: db.UpdatedAt.Unix(),
+	}
+	if db.StartedAt.Valid {
+		pb.StartTimeSeconds = db.StartedAt.Time.Unix()
+	}
+	if db.EndedAt.Valid {
+		pb.EndTimeSeconds = db.EndedAt.Time.Unix()
+	}
+	return pb
+}
+
+func parseStatsDate(v, name string) (time.Time, error) {
+	t, err := timeutil.ParseReportingDate(v)
+	if err != nil {
+		return t, errs.WrapMessagef(codes.InvalidArgument, "%s must be in the format 2006-01-02", name)
+	}
+	return t, nil
+}
+
+func (b2cAPI *b2cAPIServer) RegenerateStats(
+	ctx context.Context, req *b2c.RegenerateStatsRequest,
+) (*b2c.StatsJob, error) {
+	// Authorization
+	payload, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("regenerate request")
+	case req.StartDate == "":
+		return nil, errs.MissingField("start date")
+	case req.EndDate == "":
+		return nil, errs.MissingField("end date")
+	}
+
+	startDate, err := parseStatsDate(req.StartDate, "start date")
+	if err != nil {
+		return nil, err
+	}
+	endDate, err := parseStatsDate(req.EndDate, "end date")
+	if err != nil {
+		return nil, err
+	}
+
+	days := 0
+	for day := startDate; !day.After(endDate) && days <= maxStatsJobDays; day = day.AddDate(0, 0, 1) {
+		days++
+	}
+
+	switch {
+	case endDate.Before(startDate):
+		return nil, errs.WrapMessage(codes.InvalidArgument, "end date is before start date")
+	case days > maxStatsJobDays:
+		return nil, errs.WrapMessagef(codes.InvalidArgument, "date range cannot exceed %d days", maxStatsJobDays)
+	case endDate.After(time.Now()):
+		return nil, errs.WrapMessage(codes.InvalidArgument, "end date cannot be in the future")
+	}
+
+	for _, shortCode := range req.ShortCodes {
+		if shortCode == "" || strings.Contains(shortCode, ",") {
+			return nil, errs.IncorrectVal("short code")
+		}
+	}
+
+	jobDB := &StatsJob{
+		StartDate:   req.StartDate,
+		EndDate:     req.EndDate,
+		ShortCodes:  strings.Join(req.ShortCodes, ","),
+		SkipHourly:  req.SkipHourly,
+		Status:      b2c.StatsJobStatus_STATS_JOB_PENDING.String(),
+		DaysTotal:   int32(days),
+		RequestedBy: payload.ID,
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Create(jobDB).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to create stats job")
+	}
+
+	// The job outlives the request
+	go b2cAPI.runStatsJob(b2cAPI.ctx, jobDB, startDate, endDate)
+
+	return StatsJobProto(jobDB), nil
+}
+
+func (b2cAPI *b2cAPIServer) GetStatsJob(
+	ctx context.Context, req *b2c.GetStatsJobRequest,
+) (*b2c.StatsJob, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("get request")
+	case req.JobId == "":
+		return nil, errs.MissingField("job id")
+	}
+
+	jobDB := &StatsJob{}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).First(jobDB, "id = ?", req.JobId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("stats job", req.JobId)
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stats job")
+	}
+
+	active := jobDB.Status == b2c.StatsJobStatus_STATS_JOB_PENDING.String() ||
+		jobDB.Status == b2c.StatsJobStatus_STATS_JOB_RUNNING.String()
+
+	if active && time.Since(jobDB.lastAlive()) > statsJobStaleAfter {
+		b2cAPI.failStatsJob(ctx, jobDB, errors.New("job stopped making progress"))
+	}
+
+	return StatsJobProto(jobDB), nil
+}
+
+func (b2cAPI *b2cAPIServer) updateStatsJob(ctx context.Context, jobDB *StatsJob, updates map[string]interface{}) {
+	err := b2cAPI.SQLDB.WithContext(ctx).Model(jobDB).Updates(updates).Error
+	if err != nil {
+		b2cAPI.Logger.Errorf("STATS JOB: failed to update job [%d]: %v", jobDB.ID, err)
+	}
+}
+
+func (b2cAPI *b2cAPIServer) failStatsJob(ctx context.Context, jobDB *StatsJob, err error) {
+	b2cAPI.Logger.Errorf("STATS JOB: job [%d] failed: %v", jobDB.ID, err)
+
+	metrics.ObserveStatsWorkerRun(metrics.WorkerStatsJob, err)
+
+	// The job is marked failed even when the run was cancelled
+	b2cAPI.updateStatsJob(context.Background(), jobDB, map[string]interface{}{
+		"status":   b2c.StatsJobStatus_STATS_JOB_FAILED.String(),
+		"error":    firstN(err.Error(), 300),
+		"ended_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+}
+
+// runStatsJob recomputes daily, hourly and monthly statistics for each day in the job range.
+//
+// Statistics are upserted so running a job more than once over the same range is safe.
+func (b2cAPI *b2cAPIServer) runStatsJob(ctx context.Context, jobDB *StatsJob, startDate, endDate time.Time) {
+	b2cAPI.updateStatsJob(ctx, jobDB, map[string]interface{}{
+		"status":       b2c.StatsJobStatus_STATS_JOB_RUNNING.String(),
+		"started_at":   sql.NullTime{Time: time.Now(), Valid: true},
+		"heartbeat_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+
+	// A single day of many payments can take longer than the stale period
+	heartbeatCtx, stopHeartbeat := context.WithCancel(ctx)
+	defer stopHeartbeat()
+	go b2cAPI.statsJobHeartbeat(heartbeatCtx, jobDB.ID)
+
+	shortCodes := jobDB.shortCodes()
+
+	// Hourly stats older than the retention would be deleted by the hourly worker
+	retention := b2cAPI.HourlyStatRetention
+	if retention <= 0 {
+		retention = DefaultHourlyStatRetention
+	}
+	hourlyCutoff := time.Now().UTC().Add(-retention)
+
+	months := make([]time.Time, 0)
+
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		if ctx.Err() != nil {
+			b2cAPI.failStatsJob(ctx, jobDB, ctx.Err())
+			return
+		}
+
+		endTime := day.AddDate(0, 0, 1)
+
+		err := b2cAPI.generateDailyStatistics(ctx, &day, &endTime, shortCodes...)
+		if err != nil {
+			b2cAPI.failStatsJob(ctx, jobDB, fmt.Errorf("failed to regenerate daily stats for %s: %v", day.Format("2006-01-02"), err))
+			return
+		}
+
+		if !jobDB.SkipHourly {
+			for hour := day; hour.Before(endTime); hour = hour.Add(time.Hour) {
+				if hour.Before(hourlyCutoff) {
+					continue
+				}
+				err = b2cAPI.generateHourlyStatistics(ctx, hour, shortCodes...)
+				if err != nil {
+					b2cAPI.failStatsJob(ctx, jobDB, fmt.Errorf("failed to regenerate hourly stats for %s: %v", hour.Format(hourFormat), err))
+					return
+				}
+			}
+		}
+
+		if len(months) == 0 || months[len(months)-1].Month() != day.Month() {
+			months = append(months, day)
+		}
+
+		b2cAPI.updateStatsJob(ctx, jobDB, map[string]interface{}{
+			"days_done": jobDB.DaysDone + 1,
+		})
+	}
+
+	// Monthly stats are rolled up from the regenerated daily stats
+	for _, month := range months {
+		err := b2cAPI.generateMonthlyStatistics(ctx, month, shortCodes...)
+		if err != nil {
+			b2cAPI.failStatsJob(ctx, jobDB, fmt.Errorf("failed to regenerate monthly stats for %s: %v", month.Format(monthFormat), err))
+			return
+		}
+	}
+
+	b2cAPI.updateStatsJob(ctx, jobDB, map[string]interface{}{
+		"status":   b2c.StatsJobStatus_STATS_JOB_SUCCEEDED.String(),
+		"ended_at": sql.NullTime{Time: time.Now(), Valid: true},
+	})
+
+	metrics.ObserveStatsWorkerRun(metrics.WorkerStatsJob, nil)
+
+	b2cAPI.Logger.Infof("STATS JOB: job [%d] regenerated stats for %d days", jobDB.ID, jobDB.DaysDone)
+}
+
+// statsJobHeartbeat records that the job is alive until ctx is done
+func (b2cAPI *b2cAPIServer) statsJobHeartbeat(ctx context.Context, jobID uint) {
+	ticker := time.NewTicker(statsJobHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := b2cAPI.SQLDB.WithContext(ctx).Model(&StatsJob{}).Where("id = ?", jobID).
+				UpdateColumn("heartbeat_at", sql.NullTime{Time: time.Now(), Valid: true}).Error
+			if err != nil && ctx.Err() == nil {
+				b2cAPI.Logger.Errorf("STATS JOB: failed to record heartbeat of job [%d]: %v", jobID, err)
+			}
+		}
+	}
+}
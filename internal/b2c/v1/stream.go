@@ -0,0 +1,194 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/gidyon/mpesa-b2c/pkg/pubstream"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+)
+
+// StreamOptions contains options for publishing messages to redis streams
+type StreamOptions struct {
+	// Enabled makes streams the default transport when a publish request does not specify one
+	Enabled bool
+	// MaxLen caps the approximate number of entries retained per stream; zero means unbounded
+	MaxLen int64
+	// ConsumerGroups are created on every stream before the first message is appended
+	ConsumerGroups []string
+}
+
+const (
+	// maximum number of entries counted when redis does not report group lag
+	maxStreamLagCount = 10000
+
+	// how often consumer groups of a stream are created again in case the stream was deleted
+	streamGroupsRefresh = time.Minute
+)
+
+func (b2cAPI *b2cAPIServer) useStream(publishInfo *b2c.PublishInfo) bool {
+	switch publishInfo.GetTransport() {
+	case b2c.PublishTransport_PUBLISH_TRANSPORT_STREAM:
+		return true
+	case b2c.PublishTransport_PUBLISH_TRANSPORT_PUBSUB:
+		return false
+	default:
+		return b2cAPI.StreamOptions != nil && b2cAPI.StreamOptions.Enabled
+	}
+}
+
+func (b2cAPI *b2cAPIServer) appendToStream(ctx context.Context, channel string, msg *b2c.PublishMessage, bs []byte) error {
+	key := pubstream.Key(channel)
+
+	// Consumer groups are created again now and then as appending to a deleted stream creates it without groups
+	ensuredAt, ok := b2cAPI.streamGroups.Load(key)
+	if (!ok || time.Since(ensuredAt.(time.Time)) > streamGroupsRefresh) && b2cAPI.StreamOptions != nil {
+		for _, group := range b2cAPI.StreamOptions.ConsumerGroups {
+			err := pubstream.CreateGroup(ctx, b2cAPI.RedisDB, key, group)
+			if err != nil {
+				return fmt.Errorf("failed to create consumer group %s: %v", group, err)
+			}
+		}
+		b2cAPI.streamGroups.Store(key, time.Now())
+	}
+
+	args := &redis.XAddArgs{
+		Stream: key,
+		Values: map[string]interface{}{
+			pubstream.MessageField: bs,
+			"transaction_id":       msg.GetTransactionId(),
+			"initiator_id":         msg.GetInitiatorId(),
+		},
+	}
+	if b2cAPI.StreamOptions != nil && b2cAPI.StreamOptions.MaxLen > 0 {
+		args.MaxLen = b2cAPI.StreamOptions.MaxLen
+		args.Approx = true
+	}
+
+	return b2cAPI.RedisDB.XAdd(ctx, args).Err()
+}
+
+// reads flat key value replies such as those returned by XINFO
+func infoMap(v interface{}) map[string]interface{} {
+	vals, ok := v.([]interface{})
+	if !ok {
+		return map[string]interface{}{}
+	}
+	m := make(map[string]interface{}, len(vals)/2)
+	for i := 0; i+1 < len(vals); i += 2 {
+		m[fmt.Sprint(vals[i])] = vals[i+1]
+	}
+	return m
+}
+
+func infoInt(v interface{}) int64 {
+	switch val := v.(type) {
+	case int64:
+		return val
+	case string:
+		i, _ := strconv.ParseInt(val, 10, 64)
+		return i
+	}
+	return 0
+}
+
+func (b2cAPI *b2cAPIServer) GetPublishStreamInfo(
+	ctx context.Context, req *b2c.GetPublishStreamInfoRequest,
+) (*b2c.PublishStreamInfo, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("stream info request")
+	case req.ChannelName == "":
+		return nil, errs.MissingField("channel name")
+	}
+
+	key := pubstream.Key(req.ChannelName)
+
+	length, err := b2cAPI.RedisDB.XLen(ctx, key).Result()
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stream length")
+	}
+
+	pb := &b2c.PublishStreamInfo{
+		ChannelName: req.ChannelName,
+		StreamKey:   key,
+		Length:      length,
+		Groups:      make([]*b2c.StreamGroupInfo, 0),
+	}
+
+	if length == 0 {
+		return pb, nil
+	}
+
+	// XINFO replies are read raw since their shape differs between redis versions
+	groups, err := b2cAPI.RedisDB.Do(ctx, "XINFO", "GROUPS", key).Slice()
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return pb, nil
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get stream groups")
+	}
+
+	for _, v := range groups {
+		group := infoMap(v)
+
+		groupPB := &b2c.StreamGroupInfo{
+			Name:            fmt.Sprint(group["name"]),
+			Pending:         infoInt(group["pending"]),
+			LastDeliveredId: fmt.Sprint(group["last-delivered-id"]),
+			Consumers:       make([]*b2c.StreamConsumerInfo, 0),
+		}
+
+		if lag, ok := group["lag"]; ok && lag != nil {
+			groupPB.Lag = infoInt(lag)
+		} else {
+			// Count entries not yet delivered to the group
+			msgs, err := b2cAPI.RedisDB.XRangeN(ctx, key, "("+groupPB.LastDeliveredId, "+", maxStreamLagCount).Result()
+			if err != nil {
+				b2cAPI.Logger.Errorln(err)
+				return nil, errs.WrapMessage(codes.Internal, "failed to get stream group lag")
+			}
+			groupPB.Lag = int64(len(msgs))
+		}
+
+		consumers, err := b2cAPI.RedisDB.Do(ctx, "XINFO", "CONSUMERS", key, groupPB.Name).Slice()
+		if pubstream.IsNoGroup(err) {
+			// The group was deleted since it was listed; it is created again on the next append
+			b2cAPI.streamGroups.Delete(key)
+			continue
+		}
+		if err != nil && !errors.Is(err, redis.Nil) {
+			b2cAPI.Logger.Errorln(err)
+			return nil, errs.WrapMessage(codes.Internal, "failed to get stream consumers")
+		}
+
+		for _, v := range consumers {
+			consumer := infoMap(v)
+			groupPB.Consumers = append(groupPB.Consumers, &b2c.StreamConsumerInfo{
+				Name:       fmt.Sprint(consumer["name"]),
+				Pending:    infoInt(consumer["pending"]),
+				IdleMillis: infoInt(consumer["idle"]),
+			})
+		}
+
+		pb.Groups = append(pb.Groups, groupPB)
+	}
+
+	return pb, nil
+}
@@ -0,0 +1,126 @@
+package b2c_app_v1
+
+import (
+	"github.com/gidyon/gomicro/utils/errs"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"gorm.io/gorm"
+)
+
+// paymentColumns maps B2CPayment fields to the payment columns they are read from
+var paymentColumns = map[string]string{
+	"transaction_id":                  "id",
+	"initiator_id":                    "initiator_id",
+	"initiator_customer_reference":    "initiator_customer_reference",
+	"initiator_customer_names":        "initiator_customer_names",
+	"initiator_transaction_reference": "initiator_transaction_reference",
+	"org_short_code":                  "org_short_code",
+	"command_id":                      "command_id",
+	"msisdn":                          "msisdn",
+	"amount":                          "transaction_amount",
+	"conversation_id":                 "conversation_id",
+	"original_conversation_id":        "originator_conversation_id",
+	"b2c_response_description":        "response_description",
+	"b2c_response_code":               "response_code",
+	"b2c_result_description":          "result_description",
+	"b2c_result_code":                 "result_code",
+	"receiver_party_public_name":      "receiver_public_name",
+	"mpesa_receipt_id":                "mpesa_receipt_id",
+	"working_account_funds":           "working_account_funds",
+	"utility_account_funds":           "utility_account_funds",
+	"charges_paid_account_funds":      "charges_paid_account_funds",
+	"mpesa_charges":                   "mpesa_charges",
+	"system_charges":                  "system_charges",
+	"recipient_registered":            "recipient_registered",
+	"b2c_status":                      "b2c_status",
+	"source":                          "source",
+	"tag":                             "tag",
+	"succeeded":                       "succeeded",
+	"processed":                       "processed",
+	"transaction_timestamp":           "transaction_time",
+	"create_date":                     "created_at",
+}
+
+// fields returned by the minimal view
+var minimalViewFields = []string{
+	"transaction_id",
+	"initiator_id",
+	"org_short_code",
+	"msisdn",
+	"amount",
+	"mpesa_receipt_id",
+	"b2c_status",
+	"succeeded",
+	"processed",
+	"transaction_timestamp",
+}
+
+// paymentFields returns the B2CPayment fields to read for the view or read mask; nil means all fields.
+//
+// A read mask takes precedence over the view.
+func paymentFields(view b2c.B2CPaymentView, readMask *fieldmaskpb.FieldMask) ([]string, error) {
+	if len(readMask.GetPaths()) > 0 {
+		for _, path := range readMask.GetPaths() {
+			if _, ok := paymentColumns[path]; !ok {
+				return nil, errs.WrapMessagef(codes.InvalidArgument, "unknown read mask path %q", path)
+			}
+		}
+		return readMask.GetPaths(), nil
+	}
+
+	switch view {
+	case b2c.B2CPaymentView_B2CPAYMENT_MINIMAL_VIEW:
+		return minimalViewFields, nil
+	case b2c.B2CPaymentView_B2CPAYMENT_ID_ONLY_VIEW:
+		return []string{"transaction_id"}, nil
+	}
+
+	return nil, nil
+}
+
+// selectPaymentColumns selects only the columns needed by the fields plus any extra columns
+func selectPaymentColumns(db *gorm.DB, fields []string, extra ...string) *gorm.DB {
+	if fields == nil {
+		return db
+	}
+
+	selected := make(map[string]bool, len(fields)+len(extra))
+	cols := make([]string, 0, len(fields)+len(extra))
+
+	for _, col := range extra {
+		if !selected[col] {
+			selected[col] = true
+			cols = append(cols, col)
+		}
+	}
+	for _, field := range fields {
+		if col := paymentColumns[field]; !selected[col] {
+			selected[col] = true
+			cols = append(cols, col)
+		}
+	}
+
+	return db.Select(cols)
+}
+
+// maskPayment clears payment fields that were not requested
+func maskPayment(pb *b2c.B2CPayment, fields []string) {
+	if fields == nil {
+		return
+	}
+
+	keep := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		keep[field] = true
+	}
+
+	msg := pb.ProtoReflect()
+	msg.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !keep[string(fd.Name())] {
+			msg.Clear(fd)
+		}
+		return true
+	})
+}
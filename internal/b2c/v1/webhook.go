@@ -0,0 +1,707 @@
+package b2c_app_v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gidyon/gomicro/utils/errs"
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+const (
+	// WebhookSignatureHeader is the header containing the hex encoded HMAC-SHA256 signature of a webhook event
+	WebhookSignatureHeader = "X-B2C-Signature"
+	// WebhookTimestampHeader is the header containing the unix timestamp used when signing a webhook event
+	WebhookTimestampHeader = "X-B2C-Timestamp"
+	// WebhookEventHeader is the header containing the webhook event type
+	WebhookEventHeader = "X-B2C-Event"
+	// WebhookDeliveryHeader is the header containing the webhook delivery id
+	WebhookDeliveryHeader = "X-B2C-Delivery"
+
+	defaultWebhookMaxAttempts = 10
+	webhookRequestTimeout     = 15 * time.Second
+	webhookBaseBackoff        = 30 * time.Second
+	webhookMaxBackoff         = 6 * time.Hour
+	webhookBatchSize          = 50
+	webhookMaxSecretLen       = 100
+)
+
+const (
+	webhookSubscriptionsTable = "b2c_webhook_subscriptions"
+	webhookDeliveriesTable    = "b2c_webhook_deliveries"
+)
+
+// WebhookSubscription is a subscription by an initiator to receive b2c events over http
+type WebhookSubscription struct {
+	ID          uint   `gorm:"primaryKey;autoIncrement"`
+	InitiatorID string `gorm:"index;type:varchar(50);not null"`
+	URL         string `gorm:"type:varchar(500);not null"`
+	// Secret signs deliveries; it is encrypted as anyone holding it can forge deliveries
+	Secret      string         `gorm:"type:varchar(500);not null;serializer:pii"`
+	EventTypes  string         `gorm:"type:varchar(300)"`
+	Description string         `gorm:"type:varchar(300)"`
+	Active      bool           `gorm:"index"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+}
+
+// TableName is table name for model
+func (*WebhookSubscription) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), webhookSubscriptionsTable)
+	}
+	return webhookSubscriptionsTable
+}
+
+// WebhookDelivery is a log entry of an event sent to a webhook subscription
+type WebhookDelivery struct {
+	ID             uint   `gorm:"primaryKey;autoIncrement"`
+	SubscriptionID uint   `gorm:"index;not null"`
+	InitiatorID    string `gorm:"index;type:varchar(50)"`
+	TransactionID  uint   `gorm:"index"`
+	EventType      string `gorm:"index;type:varchar(50)"`
+	URL            string `gorm:"type:varchar(500);not null"`
+	Payload        string `gorm:"type:text;serializer:pii"`
+	Status         string `gorm:"index;type:varchar(50)"`
+	Attempts       int32
+	LastStatusCode int32
+	LastError      string       `gorm:"type:varchar(300)"`
+	NextAttemptAt  time.Time    `gorm:"index;precision:6"`
+	DeliveredAt    sql.NullTime `gorm:"precision:6"`
+	CreatedAt      time.Time    `gorm:"index;autoCreateTime;precision:6;not null"`
+	UpdatedAt      time.Time    `gorm:"autoUpdateTime;precision:6"`
+}
+
+// TableName is table name for model
+func (*WebhookDelivery) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), webhookDeliveriesTable)
+	}
+	return webhookDeliveriesTable
+}
+
+// WebhookEvent is the JSON body posted to webhook subscribers
+type WebhookEvent struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt int64           `json:"created"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// SignWebhookPayload computes the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" using the subscription secret
+func SignWebhookPayload(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func randomHex(n int) (string, error) {
+	bs := make([]byte, n)
+	_, err := rand.Read(bs)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bs), nil
+}
+
+func webhookEventTypes(eventTypes string) []b2c.B2CEventType {
+	pbs := make([]b2c.B2CEventType, 0)
+	for _, v := range strings.Split(eventTypes, ",") {
+		if v == "" {
+			continue
+		}
+		pbs = append(pbs, b2c.B2CEventType(b2c.B2CEventType_value[v]))
+	}
+	return pbs
+}
+
+func subscribedToEvent(eventTypes string, eventType b2c.B2CEventType) bool {
+	if eventTypes == "" {
+		return true
+	}
+	for _, v := range strings.Split(eventTypes, ",") {
+		if v == eventType.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscriptionProto gets webhook subscription protobuf from model
+func WebhookSubscriptionProto(db *WebhookSubscription) *b2c.WebhookSubscription {
+	return &b2c.WebhookSubscription{
+		SubscriptionId:    fmt.Sprint(db.ID),
+		InitiatorId:       db.InitiatorID,
+		Url:               db.URL,
+		EventTypes:        webhookEventTypes(db.EventTypes),
+		Description:       db.Description,
+		Active:            db.Active,
+		CreateTimeSeconds: db.CreatedAt.Unix(),
+		UpdateTimeSeconds: db.UpdatedAt.Unix(),
+	}
+}
+
+// WebhookDeliveryProto gets webhook delivery protobuf from model
+func WebhookDeliveryProto(db *WebhookDelivery) *b2c.WebhookDelivery {
+	pb := &b2c.WebhookDelivery{
+		DeliveryId:             fmt.Sprint(db.ID),
+		SubscriptionId:         fmt.Sprint(db.SubscriptionID),
+		InitiatorId:            db.InitiatorID,
+		TransactionId:          uint64(db.TransactionID),
+		EventType:              b2c.B2CEventType(b2c.B2CEventType_value[db.EventType]),
+		Url:                    db.URL,
+		Payload:                db.Payload,
+		Status:                 b2c.WebhookDeliveryStatus(b2c.WebhookDeliveryStatus_value[db.Status]),
+		Attempts:               db.Attempts,
+		LastStatusCode:         db.LastStatusCode,
+		LastError:              db.LastError,
+		NextAttemptTimeSeconds: db.NextAttemptAt.Unix(),
+		CreateTimeSeconds:      db.CreatedAt.Unix(),
+		UpdateTimeSeconds:      db.UpdatedAt.Unix(),
+	}
+	if db.DeliveredAt.Valid {
+		pb.DeliveredTimeSeconds = db.DeliveredAt.Time.Unix()
+	}
+	return pb
+}
+
+// CreateWebhookDeliveries queues the event for delivery to all active webhook subscriptions of the payment initiator.
+//
+// The payment is delivered as given so personal data must already be masked for the initiator.
+// It returns the number of deliveries queued.
+func CreateWebhookDeliveries(
+	ctx context.Context, sqlDB *gorm.DB, eventType b2c.B2CEventType, pb *b2c.B2CPayment,
+) (int, error) {
+	if pb.GetInitiatorId() == "" {
+		return 0, nil
+	}
+
+	subs := make([]*WebhookSubscription, 0)
+	err := sqlDB.WithContext(ctx).Find(&subs, "initiator_id = ? AND active = ?", pb.InitiatorId, true).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to get webhook subscriptions: %v", err)
+	}
+
+	deliveries := make([]*WebhookDelivery, 0, len(subs))
+
+	var payload []byte
+
+	for _, sub := range subs {
+		if !subscribedToEvent(sub.EventTypes, eventType) {
+			continue
+		}
+
+		// Event payload is shared by all subscribers
+		if payload == nil {
+			data, err := protojson.Marshal(pb)
+			if err != nil {
+				return 0, fmt.Errorf("failed to marshal payment: %v", err)
+			}
+			eventID, err := randomHex(16)
+			if err != nil {
+				return 0, fmt.Errorf("failed to generate event id: %v", err)
+			}
+			payload, err = json.Marshal(&WebhookEvent{
+				ID:        "evt_" + eventID,
+				Type:      eventType.String(),
+				CreatedAt: time.Now().Unix(),
+				Data:      data,
+			})
+			if err != nil {
+				return 0, fmt.Errorf("failed to marshal webhook event: %v", err)
+			}
+		}
+
+		deliveries = append(deliveries, &WebhookDelivery{
+			SubscriptionID: sub.ID,
+			InitiatorID:    sub.InitiatorID,
+			TransactionID:  uint(pb.TransactionId),
+			EventType:      eventType.String(),
+			URL:            sub.URL,
+			Payload:        string(payload),
+			Status:         b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING.String(),
+			NextAttemptAt:  time.Now().UTC(),
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	err = sqlDB.WithContext(ctx).Create(&deliveries).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook deliveries: %v", err)
+	}
+
+	return len(deliveries), nil
+}
+
+func webhookBackoff(attempts int32) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func (b2cAPI *b2cAPIServer) webhookDeliveryWorker(ctx context.Context, dur time.Duration) {
+	ticker := time.NewTicker(dur)
+	defer ticker.Stop()
+
+	b2cAPI.heartbeat(workerWebhookDelivery, dur)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b2cAPI.heartbeat(workerWebhookDelivery, dur)
+
+			deliveries := make([]*WebhookDelivery, 0, webhookBatchSize)
+
+			err := b2cAPI.SQLDB.WithContext(ctx).Limit(webhookBatchSize).Order("next_attempt_at ASC").
+				Find(&deliveries, "status = ? AND next_attempt_at <= ?",
+					b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING.String(), time.Now().UTC()).Error
+			if err != nil {
+				b2cAPI.Logger.Errorf("WEBHOOK WORKER: failed to get pending deliveries: %v", err)
+				continue
+			}
+
+			// A batch of slow subscribers outlasts the interval, so the worker beats for every delivery
+			for _, delivery := range deliveries {
+				b2cAPI.heartbeat(workerWebhookDelivery, dur)
+				b2cAPI.deliverWebhook(ctx, delivery)
+			}
+		}
+	}
+}
+
+func (b2cAPI *b2cAPIServer) deliverWebhook(ctx context.Context, delivery *WebhookDelivery) {
+	// Claim the delivery so that other replicas skip it while the request is in flight
+	res := b2cAPI.SQLDB.WithContext(ctx).Model(&WebhookDelivery{}).
+		Where("id = ? AND status = ? AND next_attempt_at = ?", delivery.ID, delivery.Status, delivery.NextAttemptAt).
+		Update("next_attempt_at", time.Now().UTC().Add(2*webhookRequestTimeout))
+	switch {
+	case res.Error != nil:
+		b2cAPI.Logger.Errorf("WEBHOOK WORKER: failed to claim delivery %d: %v", delivery.ID, res.Error)
+		return
+	case res.RowsAffected == 0:
+		return
+	}
+
+	sub := &WebhookSubscription{}
+	err := b2cAPI.SQLDB.WithContext(ctx).First(sub, "id = ?", delivery.SubscriptionID).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		err = b2cAPI.SQLDB.WithContext(ctx).Model(delivery).Updates(map[string]interface{}{
+			"status":     b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_FAILED.String(),
+			"last_error": "webhook subscription deleted",
+		}).Error
+		if err != nil {
+			b2cAPI.Logger.Errorf("WEBHOOK WORKER: failed to update delivery %d: %v", delivery.ID, err)
+		}
+		return
+	default:
+		b2cAPI.Logger.Errorf("WEBHOOK WORKER: failed to get subscription for delivery %d: %v", delivery.ID, err)
+		return
+	}
+
+	statusCode, err := b2cAPI.postWebhook(ctx, sub, delivery)
+
+	attempts := delivery.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":         attempts,
+		"last_status_code": statusCode,
+		"last_error":       "",
+	}
+
+	switch {
+	case err == nil:
+		updates["status"] = b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_DELIVERED.String()
+		updates["delivered_at"] = sql.NullTime{Valid: true, Time: time.Now().UTC()}
+	case attempts >= b2cAPI.WebhookMaxAttempts:
+		updates["status"] = b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_FAILED.String()
+		updates["last_error"] = firstN(err.Error(), 300)
+	default:
+		updates["next_attempt_at"] = time.Now().UTC().Add(webhookBackoff(attempts))
+		updates["last_error"] = firstN(err.Error(), 300)
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Model(delivery).Updates(updates).Error
+	if err != nil {
+		b2cAPI.Logger.Errorf("WEBHOOK WORKER: failed to update delivery %d: %v", delivery.ID, err)
+	}
+}
+
+func (b2cAPI *b2cAPIServer) postWebhook(
+	ctx context.Context, sub *WebhookSubscription, delivery *WebhookDelivery,
+) (int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookRequestTimeout)
+	defer cancel()
+
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %v", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, delivery.EventType)
+	req.Header.Set(WebhookDeliveryHeader, fmt.Sprint(delivery.ID))
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhookPayload(sub.Secret, timestamp, []byte(delivery.Payload)))
+
+	res, err := b2cAPI.WebhookHTTPClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		bs, _ := io.ReadAll(io.LimitReader(res.Body, 200))
+		return int32(res.StatusCode), fmt.Errorf("expected 2xx status got %d: %s", res.StatusCode, bytes.TrimSpace(bs))
+	}
+
+	return int32(res.StatusCode), nil
+}
+
+func firstN(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+func validateWebhookURL(v string) error {
+	u, err := url.Parse(v)
+	if err != nil {
+		return errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse webhook url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return errs.IncorrectVal("webhook url")
+	}
+	return nil
+}
+
+func (b2cAPI *b2cAPIServer) CreateWebhookSubscription(
+	ctx context.Context, req *b2c.CreateWebhookSubscriptionRequest,
+) (*b2c.WebhookSubscription, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("create request")
+	case req.Subscription == nil:
+		return nil, errs.MissingField("subscription")
+	case req.Subscription.InitiatorId == "":
+		return nil, errs.MissingField("initiator id")
+	case req.Subscription.Url == "":
+		return nil, errs.MissingField("webhook url")
+	case len(req.Subscription.Secret) > webhookMaxSecretLen:
+		return nil, errs.IncorrectVal("webhook secret")
+	default:
+		err = validateWebhookURL(req.Subscription.Url)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	secret := req.Subscription.Secret
+	if secret == "" {
+		v, err := randomHex(24)
+		if err != nil {
+			return nil, errs.WrapMessage(codes.Internal, "failed to generate webhook secret")
+		}
+		secret = "whsec_" + v
+	}
+
+	eventTypes := make([]string, 0, len(req.Subscription.EventTypes))
+	for _, eventType := range req.Subscription.EventTypes {
+		if eventType == b2c.B2CEventType_B2C_EVENT_TYPE_UNSPECIFIED {
+			continue
+		}
+		eventTypes = append(eventTypes, eventType.String())
+	}
+
+	db := &WebhookSubscription{
+		InitiatorID: req.Subscription.InitiatorId,
+		URL:         req.Subscription.Url,
+		Secret:      secret,
+		EventTypes:  strings.Join(eventTypes, ","),
+		Description: req.Subscription.Description,
+		Active:      true,
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Create(db).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to create webhook subscription")
+	}
+
+	pb := WebhookSubscriptionProto(db)
+
+	// Secret is only revealed when the subscription is created
+	pb.Secret = secret
+
+	return pb, nil
+}
+
+func (b2cAPI *b2cAPIServer) ListWebhookSubscriptions(
+	ctx context.Context, req *b2c.ListWebhookSubscriptionsRequest,
+) (*b2c.ListWebhookSubscriptionsResponse, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	ID, err := parsePageTokenID(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	if len(req.InitiatorIds) > 0 {
+		db = db.Where("initiator_id IN(?)", req.InitiatorIds)
+	}
+
+	subs := make([]*WebhookSubscription, 0, pageSize+1)
+
+	err = db.Find(&subs).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get webhook subscriptions")
+	}
+
+	pbs := make([]*b2c.WebhookSubscription, 0, len(subs))
+
+	for i, sub := range subs {
+		if i == int(pageSize) {
+			break
+		}
+		pbs = append(pbs, WebhookSubscriptionProto(sub))
+		ID = sub.ID
+	}
+
+	var token string
+	if len(subs) > int(pageSize) {
+		// Next page token
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(ID)))
+	}
+
+	return &b2c.ListWebhookSubscriptionsResponse{
+		NextPageToken: token,
+		Subscriptions: pbs,
+	}, nil
+}
+
+func (b2cAPI *b2cAPIServer) DeleteWebhookSubscription(
+	ctx context.Context, req *b2c.DeleteWebhookSubscriptionRequest,
+) (*emptypb.Empty, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("delete request")
+	case req.SubscriptionId == "":
+		return nil, errs.MissingField("subscription id")
+	}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).Delete(&WebhookSubscription{}, "id = ?", req.SubscriptionId).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to delete webhook subscription")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (b2cAPI *b2cAPIServer) ListWebhookDeliveries(
+	ctx context.Context, req *b2c.ListWebhookDeliveriesRequest,
+) (*b2c.ListWebhookDeliveriesResponse, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("list request")
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize <= 0 || pageSize > defaultPageSize {
+		pageSize = defaultPageSize
+	}
+
+	ID, err := parsePageTokenID(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	db := b2cAPI.SQLDB.WithContext(ctx).Limit(int(pageSize + 1)).Order("id DESC")
+	if ID != 0 {
+		db = db.Where("id<?", ID)
+	}
+
+	// Apply filters
+	if len(req.SubscriptionIds) > 0 {
+		db = db.Where("subscription_id IN(?)", req.SubscriptionIds)
+	}
+	if len(req.TransactionIds) > 0 {
+		db = db.Where("transaction_id IN(?)", req.TransactionIds)
+	}
+	if len(req.Statuses) > 0 {
+		ss := make([]string, 0, len(req.Statuses))
+		for _, s := range req.Statuses {
+			ss = append(ss, s.String())
+		}
+		db = db.Where("status IN(?)", ss)
+	}
+
+	deliveries := make([]*WebhookDelivery, 0, pageSize+1)
+
+	err = db.Find(&deliveries).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get webhook deliveries")
+	}
+
+	pbs := make([]*b2c.WebhookDelivery, 0, len(deliveries))
+
+	for i, delivery := range deliveries {
+		if i == int(pageSize) {
+			break
+		}
+		pbs = append(pbs, WebhookDeliveryProto(delivery))
+		ID = delivery.ID
+	}
+
+	var token string
+	if len(deliveries) > int(pageSize) {
+		// Next page token
+		token = base64.StdEncoding.EncodeToString([]byte(fmt.Sprint(ID)))
+	}
+
+	return &b2c.ListWebhookDeliveriesResponse{
+		NextPageToken: token,
+		Deliveries:    pbs,
+	}, nil
+}
+
+func (b2cAPI *b2cAPIServer) RedeliverWebhook(
+	ctx context.Context, req *b2c.RedeliverWebhookRequest,
+) (*b2c.WebhookDelivery, error) {
+	// Authorization
+	_, err := b2cAPI.AuthAPI.AuthorizeGroups(ctx, b2cAPI.AuthAPI.AdminGroups()...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validation
+	switch {
+	case req == nil:
+		return nil, errs.MissingField("redeliver request")
+	case req.DeliveryId == "":
+		return nil, errs.MissingField("delivery id")
+	}
+
+	db := &WebhookDelivery{}
+
+	err = b2cAPI.SQLDB.WithContext(ctx).First(db, "id = ?", req.DeliveryId).Error
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, errs.DoesNotExist("webhook delivery", req.DeliveryId)
+	default:
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to get webhook delivery")
+	}
+
+	// Schedule the delivery for the next worker run
+	err = b2cAPI.SQLDB.WithContext(ctx).Model(db).Updates(map[string]interface{}{
+		"status":          b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING.String(),
+		"next_attempt_at": time.Now().UTC(),
+		"attempts":        0,
+	}).Error
+	if err != nil {
+		b2cAPI.Logger.Errorln(err)
+		return nil, errs.WrapMessage(codes.Internal, "failed to schedule webhook delivery")
+	}
+
+	db.Status = b2c.WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING.String()
+	db.NextAttemptAt = time.Now().UTC()
+	db.Attempts = 0
+
+	return WebhookDeliveryProto(db), nil
+}
+
+func parsePageTokenID(pageToken string) (uint, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	bs, err := base64.StdEncoding.DecodeString(pageToken)
+	if err != nil {
+		return 0, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "failed to parse page token")
+	}
+	v, err := strconv.ParseUint(string(bs), 10, 64)
+	if err != nil {
+		return 0, errs.WrapErrorWithCodeAndMsg(codes.InvalidArgument, err, "incorrect page token")
+	}
+	return uint(v), nil
+}
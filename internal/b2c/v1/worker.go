@@ -7,10 +7,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/gidyon/mpesa-b2c/pkg/utils/httputils"
+	"github.com/gidyon/mpesa-b2c/internal/metrics"
+	"github.com/gidyon/mpesa-b2c/internal/tracing"
 )
 
 func (b2cAPI *b2cAPIServer) updateAccessTokenWorker(ctx context.Context, dur time.Duration) {
@@ -20,12 +22,18 @@ func (b2cAPI *b2cAPIServer) updateAccessTokenWorker(ctx context.Context, dur tim
 		ticker      = time.NewTicker(dur)
 		updateToken func()
 	)
+	defer ticker.Stop()
 
 	updateToken = func() {
 		err = b2cAPI.updateAccessToken()
 		if err != nil {
+			metrics.TokenRefreshFailures.Inc()
+			b2cAPI.tokenState.failed(err)
 			b2cAPI.Logger.Errorf("failed to update access token: %v", err)
-			time.Sleep(sleep)
+			select {
+			case <-ctx.Done():
+			case <-time.After(sleep):
+			}
 			sleep = sleep * 2
 		} else {
 			b2cAPI.Logger.Infoln("access token updated")
@@ -49,22 +57,21 @@ func (b2cAPI *b2cAPIServer) updateAccessTokenWorker(ctx context.Context, dur tim
 }
 
 func (b2cAPI *b2cAPIServer) updateAccessToken() error {
-	req, err := http.NewRequest(http.MethodGet, b2cAPI.B2COptions.AccessTokenURL, nil)
+	ctx, span := tracing.Tracer().Start(context.Background(), "b2c.RefreshAccessToken")
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b2cAPI.B2COptions.AccessTokenURL, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %v", err)
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", b2cAPI.B2COptions.basicToken))
 
-	httputils.DumpRequest(req, "B2C ACCESS TOKEN REQUEST")
-
-	res, err := b2cAPI.HTTPClient.Do(req)
+	res, err := b2cAPI.doDaraja(metrics.EndpointAccessToken, req)
 	if err != nil && !errors.Is(err, io.EOF) {
 		return fmt.Errorf("request failed: %v", err)
 	}
 
-	httputils.DumpResponse(res, "B2C ACCESS TOKEN RESPONSE")
-
 	switch {
 	case res.StatusCode != http.StatusOK:
 		return fmt.Errorf("expected status ok got: %v", res.StatusCode)
@@ -81,5 +88,12 @@ func (b2cAPI *b2cAPIServer) updateAccessToken() error {
 
 	b2cAPI.B2COptions.accessToken = fmt.Sprint(resTo["access_token"])
 
+	// Daraja tokens are valid for an hour
+	expiresIn, err := strconv.Atoi(fmt.Sprint(resTo["expires_in"]))
+	if err != nil || expiresIn <= 0 {
+		expiresIn = 3599
+	}
+	b2cAPI.tokenState.refreshed(time.Duration(expiresIn) * time.Second)
+
 	return nil
 }
@@ -0,0 +1,104 @@
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const workerFencesTable = "b2c_worker_fences"
+
+// WorkerFence is the fencing token of the latest run of a periodic worker
+type WorkerFence struct {
+	Worker    string    `gorm:"primaryKey;type:varchar(50)"`
+	Token     int64     `gorm:"not null"`
+	Holder    string    `gorm:"type:varchar(100)"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime;precision:6"`
+}
+
+// TableName is table name for model
+func (*WorkerFence) TableName() string {
+	if viper.GetString("B2C_TABLE_PREFIX") != "" {
+		return fmt.Sprintf("%s_%s", viper.GetString("B2C_TABLE_PREFIX"), workerFencesTable)
+	}
+	return workerFencesTable
+}
+
+type workerRunKey struct{}
+
+// workerRun is a run of a periodic worker fenced by the token it took
+type workerRun struct {
+	worker string
+	token  int64
+}
+
+// errStaleWorkerRun is returned for writes of a run that a newer run has fenced off
+var errStaleWorkerRun = errors.New("worker run was fenced off by a newer run")
+
+// leaderRun reports whether this replica should run the worker now and returns the context of the run.
+//
+// Only the leader runs periodic workers. Each run takes a new fencing token from the database, which survives
+// the loss of redis state, and writes made through fencedTransaction are refused once a newer run has taken a
+// token. This stops a replica that lost its lease without noticing from writing over a newer leader's results.
+func (b2cAPI *b2cAPIServer) leaderRun(ctx context.Context, worker string) (context.Context, bool) {
+	if _, ok := b2cAPI.Leader.Lease(); !ok {
+		return ctx, false
+	}
+
+	run := &workerRun{worker: worker}
+
+	err := b2cAPI.SQLDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&WorkerFence{Worker: worker}).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&WorkerFence{}).Where("worker = ?", worker).Updates(map[string]interface{}{
+			"token":  gorm.Expr("token + 1"),
+			"holder": b2cAPI.Leader.InstanceID(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		fence := &WorkerFence{}
+		err = tx.First(fence, "worker = ?", worker).Error
+		if err != nil {
+			return err
+		}
+		run.token = fence.Token
+
+		return nil
+	})
+	if err != nil {
+		b2cAPI.Logger.Errorf("failed to claim %s worker fence: %v", worker, err)
+		return ctx, false
+	}
+
+	return context.WithValue(ctx, workerRunKey{}, run), true
+}
+
+// fencedTransaction runs fn in a transaction that fails with errStaleWorkerRun when the worker run in ctx is stale.
+//
+// The fence row stays locked until the transaction ends so that a newer run cannot start in between.
+func (b2cAPI *b2cAPIServer) fencedTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	return b2cAPI.SQLDB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if run, ok := ctx.Value(workerRunKey{}).(*workerRun); ok {
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				First(&WorkerFence{}, "worker = ? AND token = ?", run.worker, run.token).Error
+			switch {
+			case err == nil:
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				return errStaleWorkerRun
+			default:
+				return err
+			}
+		}
+		return fn(tx)
+	})
+}
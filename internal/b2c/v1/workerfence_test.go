@@ -0,0 +1,66 @@
+//go:build cgo
+
+package b2c_app_v1
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestFencedTransaction(t *testing.T) {
+	sqlDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "b2c.db")), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sqlDB.AutoMigrate(&WorkerFence{}, &HourlyStat{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sqlDB.Create(&WorkerFence{Worker: workerHourlyStats, Token: 2}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b2cAPI := &b2cAPIServer{Options: &Options{SQLDB: sqlDB}}
+
+	write := func(ctx context.Context, shortCode string) error {
+		return b2cAPI.fencedTransaction(ctx, func(tx *gorm.DB) error {
+			return tx.Create(&HourlyStat{OrgShortCode: shortCode, Hour: "2024-01-01T00"}).Error
+		})
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		shortCode string
+		wantErr   error
+	}{
+		{"current run", context.WithValue(context.Background(), workerRunKey{}, &workerRun{workerHourlyStats, 2}), "600100", nil},
+		{"stale run", context.WithValue(context.Background(), workerRunKey{}, &workerRun{workerHourlyStats, 1}), "600200", errStaleWorkerRun},
+		{"no run", context.Background(), "600300", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := write(tt.ctx, tt.shortCode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+
+			var count int64
+			err = sqlDB.Model(&HourlyStat{}).Where("org_short_code = ?", tt.shortCode).Count(&count).Error
+			if err != nil {
+				t.Fatal(err)
+			}
+			if saved := count == 1; saved != (tt.wantErr == nil) {
+				t.Errorf("saved = %v", saved)
+			}
+		})
+	}
+}
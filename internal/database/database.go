@@ -0,0 +1,109 @@
+// Package database opens the sql database of the b2c service for the configured dialect
+package database
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/gidyon/gomicro/pkg/conn"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Supported dialects
+const (
+	DialectMySQL    = "mysql"
+	DialectPostgres = "postgres"
+	DialectSQLite   = "sqlite"
+)
+
+// Options contains options for opening the database
+type Options struct {
+	*conn.DbOptions
+	// SSLMode is the postgres sslmode; defaults to disable
+	SSLMode string
+}
+
+// Open opens a gorm connection for the dialect in the options; mysql is used when the dialect is empty.
+//
+// For sqlite the schema, or the address when the schema is empty, is the path of the database file.
+// The sqlite driver requires a cgo enabled build.
+func Open(opt *Options) (*gorm.DB, error) {
+	if opt == nil || opt.DbOptions == nil {
+		return nil, fmt.Errorf("missing database options")
+	}
+
+	var dialector gorm.Dialector
+
+	switch Dialect(opt.Dialect) {
+	case DialectMySQL:
+		return conn.OpenGorm(opt.DbOptions)
+	case DialectPostgres:
+		host, port, err := net.SplitHostPort(opt.Address)
+		if err != nil {
+			host, port = opt.Address, "5432"
+		}
+		sslMode := opt.SSLMode
+		if sslMode == "" {
+			sslMode = "disable"
+		}
+		dsn := &url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(opt.User, opt.Password),
+			Host:     net.JoinHostPort(host, port),
+			Path:     "/" + opt.Schema,
+			RawQuery: url.Values{"sslmode": {sslMode}}.Encode(),
+		}
+		dialector = postgres.Open(dsn.String())
+	case DialectSQLite:
+		path := opt.Schema
+		if path == "" {
+			path = opt.Address
+		}
+		dialector = sqlite.Open(path)
+	default:
+		return nil, fmt.Errorf("unsupported database dialect %q", opt.Dialect)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("(GORM) failed to open connection to %s database [name=%s] [address=%s]: %v", opt.Dialect, opt.Name, opt.Address, err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.ConnPool != nil {
+		if opt.ConnPool.MaxIdleConns != 0 {
+			sqlDB.SetMaxIdleConns(int(opt.ConnPool.MaxIdleConns))
+		}
+		if opt.ConnPool.MaxOpenConns != 0 {
+			sqlDB.SetMaxOpenConns(int(opt.ConnPool.MaxOpenConns))
+		}
+		if opt.ConnPool.MaxLifetime != 0 {
+			sqlDB.SetConnMaxLifetime(opt.ConnPool.MaxLifetime)
+		}
+	}
+
+	return db, nil
+}
+
+// Dialect normalises a configured dialect name
+func Dialect(name string) string {
+	switch name {
+	case "", "mysql":
+		return DialectMySQL
+	case "postgres", "postgresql", "pgx":
+		return DialectPostgres
+	case "sqlite", "sqlite3":
+		return DialectSQLite
+	}
+	return name
+}
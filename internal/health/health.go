@@ -0,0 +1,211 @@
+// Package health reports liveness and readiness of the b2c service over http and the gRPC health protocol
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Check statuses
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+const defaultCheckTimeout = 3 * time.Second
+
+// CheckFunc checks a dependency; the returned detail is reported whether or not the check fails
+type CheckFunc func(ctx context.Context) (detail map[string]interface{}, err error)
+
+type check struct {
+	name     string
+	fn       CheckFunc
+	liveness bool
+}
+
+// Registry holds the checks that decide whether the service is alive and ready
+type Registry struct {
+	mu      sync.RWMutex
+	checks  []*check
+	timeout time.Duration
+}
+
+// NewRegistry creates a registry whose checks each run with the given timeout; defaults to 3 seconds
+func NewRegistry(timeout time.Duration) *Registry {
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+	return &Registry{timeout: timeout}
+}
+
+// AddLivenessCheck adds a check that fails liveness and readiness; use it only for failures that a restart fixes
+func (r *Registry) AddLivenessCheck(name string, fn CheckFunc) {
+	r.add(&check{name: name, fn: fn, liveness: true})
+}
+
+// AddReadinessCheck adds a check that fails readiness
+func (r *Registry) AddReadinessCheck(name string, fn CheckFunc) {
+	r.add(&check{name: name, fn: fn})
+}
+
+func (r *Registry) add(c *check) {
+	r.mu.Lock()
+	r.checks = append(r.checks, c)
+	r.mu.Unlock()
+}
+
+// Result is the outcome of running checks
+type Result struct {
+	Status string                  `json:"status"`
+	Checks map[string]*CheckResult `json:"checks"`
+}
+
+// CheckResult is the outcome of a single check
+type CheckResult struct {
+	Status         string                 `json:"status"`
+	Error          string                 `json:"error,omitempty"`
+	DurationMillis int64                  `json:"duration_ms"`
+	Detail         map[string]interface{} `json:"detail,omitempty"`
+}
+
+// OK reports whether all checks passed
+func (res *Result) OK() bool {
+	return res.Status == StatusOK
+}
+
+// Run runs the liveness checks, or all checks when liveness is false, concurrently
+func (r *Registry) Run(ctx context.Context, liveness bool) *Result {
+	r.mu.RLock()
+	checks := make([]*check, 0, len(r.checks))
+	for _, c := range r.checks {
+		if c.liveness || !liveness {
+			checks = append(checks, c)
+		}
+	}
+	r.mu.RUnlock()
+
+	var (
+		res = &Result{Status: StatusOK, Checks: make(map[string]*CheckResult, len(checks))}
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+	)
+
+	for _, c := range checks {
+		wg.Add(1)
+		go func(c *check) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(ctx, r.timeout)
+			defer cancel()
+
+			start := time.Now()
+			detail, err := c.fn(ctx)
+
+			checkRes := &CheckResult{
+				Status:         StatusOK,
+				DurationMillis: time.Since(start).Milliseconds(),
+				Detail:         detail,
+			}
+			if err != nil {
+				checkRes.Status = StatusFail
+				checkRes.Error = err.Error()
+			}
+
+			mu.Lock()
+			res.Checks[c.name] = checkRes
+			if err != nil {
+				res.Status = StatusFail
+			}
+			mu.Unlock()
+		}(c)
+	}
+
+	wg.Wait()
+
+	return res
+}
+
+// LivenessHandler serves the liveness checks as JSON; it responds with 503 when a check fails
+func (r *Registry) LivenessHandler() http.Handler {
+	return r.handler(true)
+}
+
+// ReadinessHandler serves all checks as JSON; it responds with 503 when a check fails
+func (r *Registry) ReadinessHandler() http.Handler {
+	return r.handler(false)
+}
+
+func (r *Registry) handler(liveness bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		res := r.Run(req.Context(), liveness)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		if !res.OK() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+}
+
+// GRPCServer serves the standard gRPC health service from the registry readiness
+type GRPCServer struct {
+	*grpchealth.Server
+	registry *Registry
+	services []string
+}
+
+// NewGRPCServer creates a gRPC health server for the overall server and the named services
+func (r *Registry) NewGRPCServer(services ...string) *GRPCServer {
+	return &GRPCServer{
+		Server:   grpchealth.NewServer(),
+		registry: r,
+		services: append([]string{""}, services...),
+	}
+}
+
+// AuthFuncOverride lets health probes through without credentials
+func (*GRPCServer) AuthFuncOverride(ctx context.Context, _ string) (context.Context, error) {
+	return ctx, nil
+}
+
+// Register registers the health service on the gRPC server
+func (s *GRPCServer) Register(srv *grpc.Server) {
+	grpc_health_v1.RegisterHealthServer(srv, s)
+}
+
+// Monitor runs the readiness checks every interval and updates the serving status until ctx is done
+func (s *GRPCServer) Monitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		if !s.registry.Run(ctx, false).OK() {
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+		for _, service := range s.services {
+			s.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
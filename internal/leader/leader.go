@@ -0,0 +1,262 @@
+// Package leader elects a single replica to run periodic workers using a redis lease with fencing tokens
+package leader
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/grpclog"
+)
+
+const (
+	defaultLeaseTTL = 30 * time.Second
+	keyPrefix       = "leaderlock:"
+)
+
+// renewScript extends the lease only when it is still held by the caller
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript deletes the lease only when it is still held by the caller
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// Options contains options for an elector
+type Options struct {
+	// Name of the election; replicas with the same name compete for one lease
+	Name string
+	// LeaseTTL is how long a lease lasts without renewal; defaults to 30 seconds
+	LeaseTTL time.Duration
+	// InstanceID identifies the replica; defaults to the hostname with a random suffix
+	InstanceID string
+	Logger     grpclog.LoggerV2
+}
+
+// Elector competes for a lease and renews it while held.
+//
+// Each new lease gets a fencing token greater than that of every earlier lease so that
+// work started by a replica that lost its lease can be rejected.
+type Elector struct {
+	redisDB    *redis.Client
+	key        string
+	fenceKey   string
+	ttl        time.Duration
+	instanceID string
+	logger     grpclog.LoggerV2
+
+	mu        sync.RWMutex
+	leader    bool
+	token     int64
+	since     time.Time
+	expiresAt time.Time
+	lastErr   error
+}
+
+// NewElector creates an elector; call Run to take part in the election
+func NewElector(redisDB *redis.Client, opt *Options) (*Elector, error) {
+	switch {
+	case redisDB == nil:
+		return nil, errors.New("missing redis db")
+	case opt == nil:
+		return nil, errors.New("missing options")
+	case opt.Name == "":
+		return nil, errors.New("missing election name")
+	case opt.Logger == nil:
+		return nil, errors.New("missing logger")
+	}
+
+	ttl := opt.LeaseTTL
+	if ttl <= 0 {
+		ttl = defaultLeaseTTL
+	}
+
+	instanceID := opt.InstanceID
+	if instanceID == "" {
+		instanceID = newInstanceID()
+	}
+
+	return &Elector{
+		redisDB:    redisDB,
+		key:        keyPrefix + opt.Name,
+		fenceKey:   keyPrefix + opt.Name + ":fence",
+		ttl:        ttl,
+		instanceID: instanceID,
+		logger:     opt.Logger,
+	}, nil
+}
+
+func newInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "b2c"
+	}
+	bs := make([]byte, 4)
+	_, _ = rand.Read(bs)
+	return fmt.Sprintf("%s-%s", host, hex.EncodeToString(bs))
+}
+
+// InstanceID is the id of this replica
+func (e *Elector) InstanceID() string {
+	return e.instanceID
+}
+
+// Run takes part in the election until ctx is done, then releases the lease if held
+func (e *Elector) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		if e.isLeader() {
+			e.renew(ctx)
+		} else {
+			e.acquire(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			e.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Elector) isLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+func (e *Elector) acquire(ctx context.Context) {
+	ok, err := e.redisDB.SetNX(ctx, e.key, e.instanceID, e.ttl).Result()
+	if err != nil {
+		e.setErr(err)
+		return
+	}
+	if !ok {
+		e.setErr(nil)
+		return
+	}
+
+	token, err := e.redisDB.Incr(ctx, e.fenceKey).Result()
+	if err != nil {
+		// A lease without a token cannot be fenced
+		e.setErr(err)
+		_ = releaseScript.Run(ctx, e.redisDB, []string{e.key}, e.instanceID).Err()
+		return
+	}
+
+	now := time.Now()
+
+	e.mu.Lock()
+	e.leader = true
+	e.token = token
+	e.since = now
+	e.expiresAt = now.Add(e.ttl)
+	e.lastErr = nil
+	e.mu.Unlock()
+
+	e.logger.Infof("leader: %s acquired %s with fencing token %d", e.instanceID, e.key, token)
+}
+
+func (e *Elector) renew(ctx context.Context) {
+	start := time.Now()
+
+	res, err := renewScript.Run(ctx, e.redisDB, []string{e.key}, e.instanceID, e.ttl.Milliseconds()).Int64()
+	if err != nil {
+		// Leadership lapses on its own once the lease would have expired
+		e.setErr(err)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.lastErr = nil
+	if res == 1 {
+		e.expiresAt = start.Add(e.ttl)
+		return
+	}
+
+	e.leader = false
+	e.logger.Warningf("leader: %s lost %s", e.instanceID, e.key)
+}
+
+func (e *Elector) release() {
+	if !e.isLeader() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := releaseScript.Run(ctx, e.redisDB, []string{e.key}, e.instanceID).Err()
+	if err != nil {
+		e.logger.Errorf("leader: failed to release %s: %v", e.key, err)
+	}
+
+	e.mu.Lock()
+	e.leader = false
+	e.mu.Unlock()
+}
+
+func (e *Elector) setErr(err error) {
+	e.mu.Lock()
+	e.lastErr = err
+	e.mu.Unlock()
+}
+
+// Lease returns the fencing token of the lease when this replica holds an unexpired lease
+func (e *Elector) Lease() (token int64, ok bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if !e.leader || !time.Now().Before(e.expiresAt) {
+		return 0, false
+	}
+	return e.token, true
+}
+
+// HealthCheck reports the election status; it never fails as followers are healthy
+func (e *Elector) HealthCheck(ctx context.Context) (map[string]interface{}, error) {
+	token, ok := e.Lease()
+
+	e.mu.RLock()
+	detail := map[string]interface{}{
+		"instance_id": e.instanceID,
+		"is_leader":   ok,
+	}
+	if ok {
+		detail["fencing_token"] = token
+		detail["leader_since"] = e.since.UTC().Format(time.RFC3339)
+	}
+	if e.lastErr != nil {
+		detail["last_error"] = e.lastErr.Error()
+	}
+	e.mu.RUnlock()
+
+	holder, err := e.redisDB.Get(ctx, e.key).Result()
+	switch {
+	case err == nil:
+		detail["leader"] = holder
+	case errors.Is(err, redis.Nil):
+		detail["leader"] = ""
+	}
+
+	return detail, nil
+}
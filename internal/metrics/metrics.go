@@ -0,0 +1,111 @@
+// Package metrics contains prometheus metrics for the b2c service
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "mpesa_b2c"
+
+// Transfer outcomes
+const (
+	TransferSubmitted = "submitted"
+	TransferAccepted  = "accepted"
+	TransferRejected  = "rejected"
+)
+
+// Daraja endpoints
+const (
+	EndpointB2C          = "b2c"
+	EndpointAccessToken  = "access_token"
+	EndpointQueryBalance = "query_balance"
+	EndpointReversal     = "reversal"
+)
+
+// Stats workers
+const (
+	WorkerDailyStats  = "daily_stats"
+	WorkerHourlyStats = "hourly_stats"
+	WorkerStatsJob    = "stats_job"
+)
+
+var (
+	// Transfers counts transfer requests sent to mpesa and whether mpesa accepted them
+	Transfers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "transfers_total",
+		Help:      "Transfer requests by short code, command and outcome.",
+	}, []string{"short_code", "command_id", "outcome"})
+
+	// Callbacks counts results received from mpesa
+	Callbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "callbacks_total",
+		Help:      "Mpesa result callbacks by short code and result code.",
+	}, []string{"short_code", "result_code"})
+
+	// DarajaRequestDuration observes the latency of requests to daraja
+	DarajaRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "daraja_request_duration_seconds",
+		Help:      "Latency of requests to daraja by endpoint and response status.",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 15, 30},
+	}, []string{"endpoint", "status"})
+
+	// TokenRefreshFailures counts failed access token refreshes
+	TokenRefreshFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "token_refresh_failures_total",
+		Help:      "Failed daraja access token refreshes.",
+	})
+
+	// StatsWorkerRuns counts statistics generation runs
+	StatsWorkerRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "stats_worker_runs_total",
+		Help:      "Statistics generation runs by worker and outcome.",
+	}, []string{"worker", "outcome"})
+
+	// PublishFailures counts payment messages that could not be published
+	PublishFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "publish_failures_total",
+		Help:      "Failed payment publishes by transport.",
+	}, []string{"transport"})
+
+	// AccountBalance is the last known balance of a short code account
+	AccountBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "account_balance",
+		Help:      "Last known working and utility account balances by short code.",
+	}, []string{"short_code", "account"})
+)
+
+// Handler serves the metrics in the prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveDarajaRequest records a request to daraja that started at start; res is nil when the request failed
+func ObserveDarajaRequest(endpoint string, start time.Time, res *http.Response) {
+	status := "error"
+	if res != nil {
+		status = strconv.Itoa(res.StatusCode)
+	}
+	DarajaRequestDuration.WithLabelValues(endpoint, status).Observe(time.Since(start).Seconds())
+}
+
+// ObserveStatsWorkerRun records a statistics generation run
+func ObserveStatsWorkerRun(worker string, err error) {
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+	StatsWorkerRuns.WithLabelValues(worker, outcome).Inc()
+}
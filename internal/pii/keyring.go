@@ -0,0 +1,301 @@
+// Package pii encrypts personal data at rest with envelope encryption under keys from a local keyring file
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	keySize = 32
+
+	// ciphertextPrefix marks encrypted values; values without it are plaintext written before encryption was enabled
+	ciphertextPrefix = "enc1."
+)
+
+var b64 = base64.RawURLEncoding
+
+// keyringFile is the keyring as stored on disk
+type keyringFile struct {
+	Primary       string     `json:"primary"`
+	BlindIndexKey string     `json:"blind_index_key"`
+	Keys          []*keyFile `json:"keys"`
+}
+
+type keyFile struct {
+	ID        string    `json:"id"`
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Keyring holds the key encryption keys and the blind index key.
+//
+// New values are encrypted under the primary key; older keys are kept to decrypt values written before a rotation.
+// The blind index key never rotates as that would break lookups of existing values.
+type Keyring struct {
+	file     *keyringFile
+	primary  string
+	keys     map[string]cipher.AEAD
+	blindKey []byte
+}
+
+// NewKeyring creates a keyring with a new primary key and blind index key
+func NewKeyring() (*Keyring, error) {
+	blindKey, err := randomBytes(keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	kr := &Keyring{
+		file:     &keyringFile{BlindIndexKey: b64.EncodeToString(blindKey)},
+		keys:     map[string]cipher.AEAD{},
+		blindKey: blindKey,
+	}
+
+	_, err = kr.Rotate()
+	if err != nil {
+		return nil, err
+	}
+
+	return kr, nil
+}
+
+// LoadKeyring reads a keyring file
+func LoadKeyring(path string) (*Keyring, error) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keyring: %v", err)
+	}
+
+	file := &keyringFile{}
+	err = json.Unmarshal(bs, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode keyring: %v", err)
+	}
+
+	blindKey, err := b64.DecodeString(file.BlindIndexKey)
+	if err != nil || len(blindKey) != keySize {
+		return nil, errors.New("keyring blind index key must be 32 base64 encoded bytes")
+	}
+
+	kr := &Keyring{
+		file:     file,
+		primary:  file.Primary,
+		keys:     make(map[string]cipher.AEAD, len(file.Keys)),
+		blindKey: blindKey,
+	}
+
+	for _, k := range file.Keys {
+		if !validKeyID(k.ID) {
+			return nil, fmt.Errorf("keyring key id %q must be letters, digits and dashes", k.ID)
+		}
+		key, err := b64.DecodeString(k.Key)
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("keyring key %s must be 32 base64 encoded bytes", k.ID)
+		}
+		kr.keys[k.ID], err = newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if _, ok := kr.keys[kr.primary]; !ok {
+		return nil, fmt.Errorf("keyring primary key %q does not exist", kr.primary)
+	}
+
+	return kr, nil
+}
+
+// Save writes the keyring file, replacing it atomically
+func (kr *Keyring) Save(path string) error {
+	bs, err := json.MarshalIndent(kr.file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".keyring-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(bs)
+	if err == nil {
+		err = tmp.Chmod(0o600)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Rotate adds a new key and makes it the primary key; it returns the id of the new key
+func (kr *Keyring) Rotate() (string, error) {
+	key, err := randomBytes(keySize)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	id := fmt.Sprintf("k%d", len(kr.file.Keys)+1)
+	for kr.keys[id] != nil {
+		id += "-1"
+	}
+
+	kr.file.Keys = append(kr.file.Keys, &keyFile{ID: id, Key: b64.EncodeToString(key), CreatedAt: time.Now().UTC()})
+	kr.file.Primary = id
+	kr.keys[id] = aead
+	kr.primary = id
+
+	return id, nil
+}
+
+// PrimaryKeyID is the id of the key new values are encrypted under
+func (kr *Keyring) PrimaryKeyID() string {
+	return kr.primary
+}
+
+// Encrypt encrypts the plaintext under a new data key which is itself encrypted under the primary key
+func (kr *Keyring) Encrypt(plaintext []byte) ([]byte, error) {
+	dataKey, err := randomBytes(keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := seal(kr.keys[kr.primary], dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := seal(dataAEAD, plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(ciphertextPrefix + kr.primary + "." + b64.EncodeToString(wrappedKey) + "." + b64.EncodeToString(ciphertext)), nil
+}
+
+// Decrypt decrypts a value from Encrypt
+func (kr *Keyring) Decrypt(value []byte) ([]byte, error) {
+	parts := strings.Split(strings.TrimPrefix(string(value), ciphertextPrefix), ".")
+	if !IsEncrypted(value) || len(parts) != 3 {
+		return nil, errors.New("value is not encrypted")
+	}
+
+	keyAEAD, ok := kr.keys[parts[0]]
+	if !ok {
+		return nil, fmt.Errorf("keyring key %q does not exist", parts[0])
+	}
+
+	wrappedKey, err := b64.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	ciphertext, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := open(keyAEAD, wrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %v", err)
+	}
+
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(dataAEAD, ciphertext)
+}
+
+// Stale reports whether the value is plaintext or encrypted under a key other than the primary key
+func (kr *Keyring) Stale(value string) bool {
+	return value != "" && !strings.HasPrefix(value, ciphertextPrefix+kr.primary+".")
+}
+
+// BlindIndex is a keyed hash of the value that allows equality lookups without decrypting
+func (kr *Keyring) BlindIndex(value string) string {
+	mac := hmac.New(sha256.New, kr.blindKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsEncrypted reports whether the value is a ciphertext
+func IsEncrypted(value []byte) bool {
+	return strings.HasPrefix(string(value), ciphertextPrefix)
+}
+
+func validKeyID(id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, r := range id {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+func randomBytes(n int) ([]byte, error) {
+	bs := make([]byte, n)
+	_, err := rand.Read(bs)
+	if err != nil {
+		return nil, err
+	}
+	return bs, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts with a random nonce that is prepended to the ciphertext
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce, err := randomBytes(aead.NonceSize())
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
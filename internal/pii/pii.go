@@ -0,0 +1,127 @@
+package pii
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+
+	"gorm.io/gorm/schema"
+)
+
+// SerializerName is the gorm serializer that encrypts string columns, e.g. `gorm:"serializer:pii"`
+const SerializerName = "pii"
+
+var current atomic.Value
+
+type keyringHolder struct {
+	kr *Keyring
+}
+
+func init() {
+	current.Store(keyringHolder{})
+	schema.RegisterSerializer(SerializerName, Serializer{})
+}
+
+// SetKeyring sets the keyring used to encrypt personal data; a nil keyring stores new values in plaintext
+func SetKeyring(kr *Keyring) {
+	current.Store(keyringHolder{kr: kr})
+}
+
+// Current is the keyring in use or nil when encryption is off
+func Current() *Keyring {
+	return current.Load().(keyringHolder).kr
+}
+
+// Enabled reports whether personal data is encrypted
+func Enabled() bool {
+	return Current() != nil
+}
+
+// Encrypt encrypts a value with the current keyring; values are returned as is when encryption is off
+func Encrypt(value string) (string, error) {
+	kr := Current()
+	if kr == nil || value == "" {
+		return value, nil
+	}
+	bs, err := kr.Encrypt([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// Decrypt decrypts a value from Encrypt; plaintext values are returned as is
+func Decrypt(value string) (string, error) {
+	bs, err := Open([]byte(value))
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// Seal encrypts bytes with the current keyring; bytes are returned as is when encryption is off
+func Seal(plaintext []byte) ([]byte, error) {
+	kr := Current()
+	if kr == nil {
+		return plaintext, nil
+	}
+	return kr.Encrypt(plaintext)
+}
+
+// Open decrypts bytes from Seal; plaintext bytes are returned as is
+func Open(value []byte) ([]byte, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	kr := Current()
+	if kr == nil {
+		return nil, errors.New("value is encrypted but no keyring is configured")
+	}
+	return kr.Decrypt(value)
+}
+
+// BlindIndex is the blind index of a value or empty when encryption is off
+func BlindIndex(value string) string {
+	kr := Current()
+	if kr == nil || value == "" {
+		return ""
+	}
+	return kr.BlindIndex(value)
+}
+
+// Serializer encrypts string fields when they are written and decrypts them when they are read
+type Serializer struct{}
+
+// Scan implements schema.SerializerInterface
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	var value string
+	switch v := dbValue.(type) {
+	case nil:
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("unsupported value %T for encrypted field %s", dbValue, field.Name)
+	}
+
+	plaintext, err := Decrypt(value)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt %s: %v", field.Name, err)
+	}
+
+	field.ReflectValueOf(ctx, dst).SetString(plaintext)
+
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface
+func (Serializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue interface{}) (interface{}, error) {
+	value, ok := fieldValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("encrypted field %s must be a string", field.Name)
+	}
+	return Encrypt(value)
+}
@@ -0,0 +1,134 @@
+package pii
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func useKeyring(t *testing.T, kr *Keyring) {
+	prev := Current()
+	SetKeyring(kr)
+	t.Cleanup(func() { SetKeyring(prev) })
+}
+
+func TestSealOpenRotation(t *testing.T) {
+	kr, err := NewKeyring()
+	if err != nil {
+		t.Fatal(err)
+	}
+	useKeyring(t, kr)
+
+	plaintext := []byte("254712345678")
+
+	sealedBefore, err := Seal(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldKey := kr.PrimaryKeyID()
+
+	newKey, err := kr.Rotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealedAfter, err := Seal(plaintext)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Saved and loaded keyrings keep the keys of values sealed before a rotation
+	path := filepath.Join(t.TempDir(), "keyring.json")
+	err = kr.Save(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadKeyring(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tamperedKey := []byte(strings.Replace(string(sealedAfter), "."+newKey+".", ".k99.", 1))
+	tampered := append([]byte{}, sealedAfter...)
+	tampered[len(tampered)-2] ^= 1
+
+	tests := []struct {
+		name    string
+		keyring *Keyring
+		value   []byte
+		stale   bool
+		wantErr bool
+	}{
+		{name: "sealed under old key", keyring: kr, value: sealedBefore, stale: true},
+		{name: "sealed under primary key", keyring: kr, value: sealedAfter},
+		{name: "old key after reload", keyring: loaded, value: sealedBefore, stale: true},
+		{name: "primary key after reload", keyring: loaded, value: sealedAfter},
+		{name: "plaintext passes through", keyring: kr, value: plaintext, stale: true},
+		{name: "unknown key", keyring: kr, value: tamperedKey, wantErr: true},
+		{name: "tampered ciphertext", keyring: kr, value: tampered, wantErr: true},
+		{name: "no keyring", keyring: nil, value: sealedAfter, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useKeyring(t, tt.keyring)
+
+			got, err := Open(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(got, plaintext) {
+				t.Errorf("Open() = %q, want %q", got, plaintext)
+			}
+			if stale := tt.keyring.Stale(string(tt.value)); stale != tt.stale {
+				t.Errorf("Stale() = %v, want %v", stale, tt.stale)
+			}
+		})
+	}
+
+	if !strings.HasPrefix(string(sealedBefore), ciphertextPrefix+oldKey+".") {
+		t.Errorf("value sealed before rotation is not under key %s", oldKey)
+	}
+}
+
+func TestSealWithoutKeyring(t *testing.T) {
+	useKeyring(t, nil)
+
+	sealed, err := Seal([]byte("John Doe"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(sealed) != "John Doe" {
+		t.Errorf("Seal() = %q, want plaintext", sealed)
+	}
+	if BlindIndex("254712345678") != "" {
+		t.Error("expected no blind index without a keyring")
+	}
+}
+
+func TestBlindIndexSurvivesRotation(t *testing.T) {
+	kr, err := NewKeyring()
+	if err != nil {
+		t.Fatal(err)
+	}
+	useKeyring(t, kr)
+
+	before := BlindIndex("254712345678")
+	_, err = kr.Rotate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if after := BlindIndex("254712345678"); after != before {
+		t.Errorf("blind index changed after rotation: %s != %s", after, before)
+	}
+	if BlindIndex("254712345679") == before {
+		t.Error("different values have the same blind index")
+	}
+}
@@ -0,0 +1,339 @@
+// Package rbac authorizes callers to RPCs, short codes and initiators from a policy file
+package rbac
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AnyRPC in a rule grants all RPCs of the service
+const AnyRPC = "*"
+
+// Rule grants RPCs on payments of some short codes and initiators
+type Rule struct {
+	// RPCs are method names such as TransferFunds, or * for all
+	RPCs []string `json:"rpcs"`
+	// ShortCodes limits the rule to these organisation short codes; empty allows any
+	ShortCodes []string `json:"short_codes"`
+	// Initiators limits the rule to these initiator ids; empty allows any
+	Initiators []string `json:"initiators"`
+}
+
+func (r *Rule) grants(rpc string) bool {
+	for _, v := range r.RPCs {
+		if v == rpc || v == AnyRPC {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy maps auth groups and roles to rules.
+//
+// A caller is granted the rules of its group and of each of its roles; callers in admin groups are granted everything.
+// e.g.
+//
+//	{
+//	  "groups": {"PAYROLL": [{"rpcs": ["TransferFunds", "GetB2CPayment"], "short_codes": ["600100"]}]},
+//	  "roles": {"SUPPORT": [{"rpcs": ["GetB2CPayment", "ListB2CPayments"]}]}
+//	}
+type Policy struct {
+	Groups map[string][]*Rule `json:"groups"`
+	Roles  map[string][]*Rule `json:"roles"`
+}
+
+// LoadPolicy reads a policy file
+func LoadPolicy(file string) (*Policy, error) {
+	bs, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %v", err)
+	}
+
+	policy := &Policy{}
+
+	err = json.Unmarshal(bs, policy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %v", file, err)
+	}
+
+	return policy, nil
+}
+
+// Validate checks that the rules only name RPCs of the service
+func (p *Policy) Validate(service *grpc.ServiceDesc) error {
+	for kind, subjects := range map[string]map[string][]*Rule{"group": p.Groups, "role": p.Roles} {
+		for subject, rules := range subjects {
+			for _, rule := range rules {
+				err := rule.Validate(service)
+				if err != nil {
+					return fmt.Errorf("%s %s: %v", kind, subject, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Validate checks that the rule names RPCs of the service
+func (r *Rule) Validate(service *grpc.ServiceDesc) error {
+	if r == nil || len(r.RPCs) == 0 {
+		return errors.New("rule has no rpcs")
+	}
+
+	rpcs := map[string]bool{AnyRPC: true}
+	for _, m := range service.Methods {
+		rpcs[m.MethodName] = true
+	}
+	for _, s := range service.Streams {
+		rpcs[s.StreamName] = true
+	}
+
+	for _, rpc := range r.RPCs {
+		if !rpcs[rpc] {
+			return fmt.Errorf("rule has unknown rpc %s", rpc)
+		}
+	}
+
+	return nil
+}
+
+// Scope is the short codes and initiators a caller may act on; a nil scope is unrestricted.
+//
+// Each rule granting the rpc is kept as a grant of its own, so a payment is allowed only when a single
+// grant allows both its short code and its initiator.
+type Scope struct {
+	Grants []*Grant
+}
+
+// Grant is the short codes and initiators one rule allows
+type Grant struct {
+	// ShortCodes the grant allows; nil allows any
+	ShortCodes []string
+	// Initiators the grant allows; nil allows any
+	Initiators []string
+}
+
+// Allows reports whether the grant allows a payment of the short code by the initiator
+func (g *Grant) Allows(shortCode, initiatorID string) bool {
+	return (g.ShortCodes == nil || contains(g.ShortCodes, shortCode)) &&
+		(g.Initiators == nil || contains(g.Initiators, initiatorID))
+}
+
+// Unrestricted reports whether the scope allows every short code and initiator
+func (s *Scope) Unrestricted() bool {
+	return s == nil
+}
+
+// Allows reports whether a single grant of the scope allows a payment of the short code by the initiator
+func (s *Scope) Allows(shortCode, initiatorID string) bool {
+	if s == nil {
+		return true
+	}
+	for _, g := range s.Grants {
+		if g.Allows(shortCode, initiatorID) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsShortCode reports whether the scope allows the short code for every initiator
+func (s *Scope) AllowsShortCode(shortCode string) bool {
+	if s == nil {
+		return true
+	}
+	return contains(s.FullShortCodes(), shortCode)
+}
+
+// FullShortCodes are the short codes the scope allows for every initiator
+func (s *Scope) FullShortCodes() []string {
+	codes := map[string]bool{}
+	for _, g := range s.Grants {
+		if g.Initiators != nil {
+			continue
+		}
+		for _, v := range g.ShortCodes {
+			codes[v] = true
+		}
+	}
+	return keys(codes)
+}
+
+// Authorize returns the scope in which the payload may call the rpc or a PermissionDenied error
+func (p *Policy) Authorize(payload *auth.Payload, rpc string) (*Scope, error) {
+	rules := append([]*Rule{}, p.Groups[payload.Group]...)
+	for _, role := range payload.Roles {
+		rules = append(rules, p.Roles[role]...)
+	}
+
+	return mergeRules(rules, rpc)
+}
+
+// mergeRules returns the scope of the rules granting the rpc
+func mergeRules(rules []*Rule, rpc string) (*Scope, error) {
+	granted := make([]*Rule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.grants(rpc) {
+			granted = append(granted, rule)
+		}
+	}
+	rules = granted
+
+	if len(rules) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to call %s", rpc)
+	}
+
+	scope := &Scope{Grants: make([]*Grant, 0, len(rules))}
+	for _, rule := range rules {
+		// A rule without limits allows everything
+		if len(rule.ShortCodes) == 0 && len(rule.Initiators) == 0 {
+			return nil, nil
+		}
+		grant := &Grant{}
+		if len(rule.ShortCodes) > 0 {
+			grant.ShortCodes = rule.ShortCodes
+		}
+		if len(rule.Initiators) > 0 {
+			grant.Initiators = rule.Initiators
+		}
+		scope.Grants = append(scope.Grants, grant)
+	}
+
+	return scope, nil
+}
+
+type (
+	scopeKey   struct{}
+	keyRuleKey struct{}
+)
+
+// WithScope returns a context carrying the scope of the caller
+func WithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, scopeKey{}, scope)
+}
+
+// FromContext returns the scope of the caller; nil when the caller is unrestricted
+func FromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(scopeKey{}).(*Scope)
+	return scope
+}
+
+// WithKeyRule returns a context of a caller authenticated with an API key limited to the rule
+func WithKeyRule(ctx context.Context, rule *Rule) context.Context {
+	return context.WithValue(ctx, keyRuleKey{}, rule)
+}
+
+// KeyRuleFromContext returns the rule of the API key of the caller; nil when the caller did not use an API key
+func KeyRuleFromContext(ctx context.Context) *Rule {
+	rule, _ := ctx.Value(keyRuleKey{}).(*Rule)
+	return rule
+}
+
+// Authorizer enforces a policy on the RPCs of a service
+type Authorizer struct {
+	AuthAPI *auth.API
+	// Service is the full name of the service whose RPCs are authorized; RPCs of other services pass through
+	Service string
+	// Policy to enforce; every caller is unrestricted when nil
+	Policy *Policy
+}
+
+// Scope returns the scope in which the payload may call the rpc.
+//
+// Callers using an API key are limited to the rule of the key whatever their group.
+func (a *Authorizer) Scope(ctx context.Context, payload *auth.Payload, rpc string) (*Scope, error) {
+	if rule := KeyRuleFromContext(ctx); rule != nil {
+		return mergeRules([]*Rule{rule}, rpc)
+	}
+	if a.Policy == nil || a.AuthAPI.IsAdmin(payload.Group) {
+		return nil, nil
+	}
+	return a.Policy.Authorize(payload, rpc)
+}
+
+func (a *Authorizer) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	service, rpc, ok := splitMethod(fullMethod)
+	if !ok || service != a.Service {
+		return ctx, nil
+	}
+
+	payload, err := a.AuthAPI.GetPayload(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := a.Scope(ctx, payload, rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	return WithScope(ctx, scope), nil
+}
+
+// UnaryInterceptor authorizes unary calls; it runs after authentication
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authorizes streaming calls; it runs after authentication
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &scopedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type scopedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *scopedStream) Context() context.Context {
+	return s.ctx
+}
+
+// splitMethod splits a full method name /package.Service/Method
+func splitMethod(fullMethod string) (string, string, bool) {
+	i := strings.LastIndex(fullMethod, "/")
+	if i <= 0 {
+		return "", "", false
+	}
+	return strings.TrimPrefix(fullMethod[:i], "/"), fullMethod[i+1:], true
+}
+
+func contains(vs []string, v string) bool {
+	for _, s := range vs {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func keys(m map[string]bool) []string {
+	vs := make([]string, 0, len(m))
+	for k := range m {
+		vs = append(vs, k)
+	}
+	sort.Strings(vs)
+	return vs
+}
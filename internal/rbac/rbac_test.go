@@ -0,0 +1,160 @@
+package rbac
+
+import (
+	"testing"
+
+	auth "github.com/gidyon/gomicro/pkg/grpc/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type payment struct {
+	shortCode string
+	initiator string
+	allowed   bool
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	policy := &Policy{
+		Groups: map[string][]*Rule{
+			"PAYROLL": {
+				{RPCs: []string{"TransferFunds"}, ShortCodes: []string{"600100"}},
+				{RPCs: []string{"TransferFunds"}, Initiators: []string{"svc-a"}},
+			},
+			"OPS": {
+				{RPCs: []string{"*"}},
+			},
+			"SPLIT": {
+				{RPCs: []string{"ListB2CPayments"}, ShortCodes: []string{"600100"}, Initiators: []string{"svc-a"}},
+				{RPCs: []string{"ListB2CPayments"}, ShortCodes: []string{"600200"}, Initiators: []string{"svc-b"}},
+			},
+		},
+		Roles: map[string][]*Rule{
+			"SUPPORT": {{RPCs: []string{"GetB2CPayment"}, ShortCodes: []string{"600300"}}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		payload      *auth.Payload
+		rpc          string
+		denied       bool
+		unrestricted bool
+		payments     []payment
+	}{
+		{
+			name:    "rules with different limits are not combined",
+			payload: &auth.Payload{Group: "PAYROLL"},
+			rpc:     "TransferFunds",
+			payments: []payment{
+				{"600100", "svc-z", true},
+				{"600999", "svc-a", true},
+				{"600999", "svc-z", false},
+			},
+		},
+		{
+			name:    "short codes and initiators pair within a rule",
+			payload: &auth.Payload{Group: "SPLIT"},
+			rpc:     "ListB2CPayments",
+			payments: []payment{
+				{"600100", "svc-a", true},
+				{"600200", "svc-b", true},
+				{"600100", "svc-b", false},
+				{"600200", "svc-a", false},
+			},
+		},
+		{
+			name:         "rule without limits is unrestricted",
+			payload:      &auth.Payload{Group: "OPS"},
+			rpc:          "ReverseTransaction",
+			unrestricted: true,
+		},
+		{
+			name:    "roles grant rules",
+			payload: &auth.Payload{Group: "NONE", Roles: []string{"SUPPORT"}},
+			rpc:     "GetB2CPayment",
+			payments: []payment{
+				{"600300", "svc-z", true},
+				{"600100", "svc-z", false},
+			},
+		},
+		{
+			name:    "rpc not granted",
+			payload: &auth.Payload{Group: "PAYROLL"},
+			rpc:     "ListB2CPayments",
+			denied:  true,
+		},
+		{
+			name:    "unknown group",
+			payload: &auth.Payload{Group: "NONE"},
+			rpc:     "TransferFunds",
+			denied:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope, err := policy.Authorize(tt.payload, tt.rpc)
+			if tt.denied {
+				if status.Code(err) != codes.PermissionDenied {
+					t.Fatalf("expected PermissionDenied, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if scope.Unrestricted() != tt.unrestricted {
+				t.Fatalf("expected unrestricted %v, got %v", tt.unrestricted, scope.Unrestricted())
+			}
+			for _, p := range tt.payments {
+				if got := scope.Allows(p.shortCode, p.initiator); got != p.allowed {
+					t.Errorf("Allows(%s, %s) = %v, want %v", p.shortCode, p.initiator, got, p.allowed)
+				}
+			}
+		})
+	}
+}
+
+func TestScopeFullShortCodes(t *testing.T) {
+	tests := []struct {
+		name      string
+		scope     *Scope
+		shortCode string
+		allowed   bool
+	}{
+		{"nil scope", nil, "600100", true},
+		{"grant for all initiators", &Scope{Grants: []*Grant{{ShortCodes: []string{"600100"}}}}, "600100", true},
+		{"grant for some initiators", &Scope{Grants: []*Grant{{ShortCodes: []string{"600100"}, Initiators: []string{"svc-a"}}}}, "600100", false},
+		{"initiator grant without short codes", &Scope{Grants: []*Grant{{Initiators: []string{"svc-a"}}}}, "600100", false},
+		{"other short code", &Scope{Grants: []*Grant{{ShortCodes: []string{"600200"}}}}, "600100", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.scope.AllowsShortCode(tt.shortCode); got != tt.allowed {
+				t.Errorf("AllowsShortCode(%s) = %v, want %v", tt.shortCode, got, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestSplitMethod(t *testing.T) {
+	tests := []struct {
+		fullMethod string
+		service    string
+		rpc        string
+		ok         bool
+	}{
+		{"/gidyon.mpesa.b2c.v1.B2CV1/TransferFunds", "gidyon.mpesa.b2c.v1.B2CV1", "TransferFunds", true},
+		{"TransferFunds", "", "", false},
+		{"/TransferFunds", "", "", false},
+	}
+
+	for _, tt := range tests {
+		service, rpc, ok := splitMethod(tt.fullMethod)
+		if service != tt.service || rpc != tt.rpc || ok != tt.ok {
+			t.Errorf("splitMethod(%s) = %s, %s, %v", tt.fullMethod, service, rpc, ok)
+		}
+	}
+}
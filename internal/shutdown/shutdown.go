@@ -0,0 +1,130 @@
+// Package shutdown coordinates draining of in-flight work when the b2c service stops
+package shutdown
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Kinds of tracked work
+const (
+	KindTransfer = "transfer"
+	KindCallback = "callback"
+)
+
+// Task is a unit of in-flight work
+type Task struct {
+	Kind        string
+	Description string
+	StartedAt   time.Time
+}
+
+// Coordinator tracks in-flight work and stops new work from starting once draining begins
+type Coordinator struct {
+	mu       sync.Mutex
+	draining bool
+	nextID   uint64
+	tasks    map[uint64]*Task
+	idle     chan struct{}
+}
+
+// NewCoordinator creates a shutdown coordinator
+func NewCoordinator() *Coordinator {
+	return &Coordinator{tasks: make(map[uint64]*Task)}
+}
+
+// Begin tracks new work that should not start while draining; ok is false once draining has begun.
+//
+// The returned function must be called when the work is done.
+func (c *Coordinator) Begin(kind, description string) (done func(), ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.draining {
+		return func() {}, false
+	}
+
+	return c.track(kind, description), true
+}
+
+// Track tracks work that must be finished even while draining e.g. results of transfers already submitted.
+//
+// The returned function must be called when the work is done.
+func (c *Coordinator) Track(kind, description string) (done func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.track(kind, description)
+}
+
+func (c *Coordinator) track(kind, description string) func() {
+	c.nextID++
+	id := c.nextID
+	c.tasks[id] = &Task{Kind: kind, Description: description, StartedAt: time.Now()}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+
+			delete(c.tasks, id)
+			if len(c.tasks) == 0 && c.idle != nil {
+				close(c.idle)
+				c.idle = nil
+			}
+		})
+	}
+}
+
+// Draining reports whether draining has begun
+func (c *Coordinator) Draining() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.draining
+}
+
+// InFlight returns the number of tracked tasks
+func (c *Coordinator) InFlight() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.tasks)
+}
+
+// Drain stops new work from starting and waits for tracked work to finish or for ctx to be done.
+//
+// It returns the tasks that did not finish, oldest first.
+func (c *Coordinator) Drain(ctx context.Context) []*Task {
+	c.mu.Lock()
+	c.draining = true
+	if len(c.tasks) == 0 {
+		c.mu.Unlock()
+		return nil
+	}
+	if c.idle == nil {
+		c.idle = make(chan struct{})
+	}
+	idle := c.idle
+	c.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	unfinished := make([]*Task, 0, len(c.tasks))
+	for _, task := range c.tasks {
+		unfinished = append(unfinished, task)
+	}
+	sort.Slice(unfinished, func(i, j int) bool {
+		return unfinished[i].StartedAt.Before(unfinished[j].StartedAt)
+	})
+
+	return unfinished
+}
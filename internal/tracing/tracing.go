@@ -0,0 +1,163 @@
+// Package tracing sets up opentelemetry tracing for the b2c service
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/gidyon/mpesa-b2c"
+
+// Exporters supported by Init
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Options contains options for tracing
+type Options struct {
+	ServiceName string
+	// Exporter is one of otlp or stdout; tracing is disabled when empty
+	Exporter string
+	// OTLPEndpoint is the host:port of the OTLP gRPC collector; the OTEL_EXPORTER_OTLP_* environment is used when empty
+	OTLPEndpoint string
+	OTLPInsecure bool
+	// SampleRatio is the fraction of new traces that are sampled; all traces are sampled when zero
+	SampleRatio float64
+}
+
+// Init installs the global tracer provider and propagator.
+//
+// The returned function flushes pending spans and should be called on shutdown.
+func Init(ctx context.Context, opt *Options) (func(context.Context) error, error) {
+	// Propagate trace context even when spans are not exported
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+
+	switch opt.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		opts := make([]otlptracegrpc.Option, 0, 2)
+		if opt.OTLPEndpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(opt.OTLPEndpoint))
+		}
+		if opt.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opt.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace exporter: %v", err)
+	}
+
+	sampler := sdktrace.AlwaysSample()
+	if opt.SampleRatio > 0 && opt.SampleRatio < 1 {
+		sampler = sdktrace.TraceIDRatioBased(opt.SampleRatio)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL, semconv.ServiceName(opt.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %v", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sampler)),
+	)
+
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Tracer returns the tracer for the service
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// NewTransport wraps the round tripper so that outbound requests are traced
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(base, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return "HTTP " + r.Method + " " + r.URL.Host + r.URL.Path
+	}))
+}
+
+// credentialParams are query parameters kept out of span attributes
+var credentialParams = []string{"access_token"}
+
+// NewHandler wraps the handler so that inbound requests are traced
+func NewHandler(h http.Handler) http.Handler {
+	traced := otelhttp.NewHandler(h, "http.server", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return "HTTP " + r.Method + " " + r.URL.Path
+	}))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traced.ServeHTTP(w, withoutCredentials(r))
+	})
+}
+
+// withoutCredentials removes credentials from the request uri recorded as the http.target attribute.
+//
+// Handlers read query parameters from the url which is left as is.
+func withoutCredentials(r *http.Request) *http.Request {
+	query := r.URL.Query()
+	redact := false
+	for _, param := range credentialParams {
+		if query.Has(param) {
+			query.Set(param, "REDACTED")
+			redact = true
+		}
+	}
+	if !redact {
+		return r
+	}
+	r = r.WithContext(r.Context())
+	r.RequestURI = r.URL.EscapedPath() + "?" + query.Encode()
+	return r
+}
+
+// TraceParent returns the W3C traceparent of the span in ctx; it is empty when there is no sampled span
+func TraceParent(ctx context.Context) string {
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get("traceparent")
+}
+
+// LinkFromTraceParent returns links to the span in the W3C traceparent; none are returned when it is invalid
+func LinkFromTraceParent(traceParent string) []trace.Link {
+	if traceParent == "" {
+		return nil
+	}
+	ctx := propagation.TraceContext{}.Extract(context.Background(), propagation.MapCarrier{"traceparent": traceParent})
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return []trace.Link{{SpanContext: sc}}
+}
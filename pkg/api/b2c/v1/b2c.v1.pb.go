@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.25.0-devel
+// 	protoc-gen-go v1.28.1
 // 	protoc        v3.14.0
 // source: b2c.v1.proto
 
@@ -9,6 +9,7 @@ package b2c_v1
 import (
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -75,6 +76,55 @@ func (CommandId) EnumDescriptor() ([]byte, []int) {
 	return file_b2c_v1_proto_rawDescGZIP(), []int{0}
 }
 
+type PublishTransport int32
+
+const (
+	PublishTransport_PUBLISH_TRANSPORT_UNSPECIFIED PublishTransport = 0
+	PublishTransport_PUBLISH_TRANSPORT_PUBSUB      PublishTransport = 1
+	PublishTransport_PUBLISH_TRANSPORT_STREAM      PublishTransport = 2
+)
+
+// Enum value maps for PublishTransport.
+var (
+	PublishTransport_name = map[int32]string{
+		0: "PUBLISH_TRANSPORT_UNSPECIFIED",
+		1: "PUBLISH_TRANSPORT_PUBSUB",
+		2: "PUBLISH_TRANSPORT_STREAM",
+	}
+	PublishTransport_value = map[string]int32{
+		"PUBLISH_TRANSPORT_UNSPECIFIED": 0,
+		"PUBLISH_TRANSPORT_PUBSUB":      1,
+		"PUBLISH_TRANSPORT_STREAM":      2,
+	}
+)
+
+func (x PublishTransport) Enum() *PublishTransport {
+	p := new(PublishTransport)
+	*p = x
+	return p
+}
+
+func (x PublishTransport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishTransport) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[1].Descriptor()
+}
+
+func (PublishTransport) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[1]
+}
+
+func (x PublishTransport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishTransport.Descriptor instead.
+func (PublishTransport) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{1}
+}
+
 type B2CStatus int32
 
 const (
@@ -83,6 +133,9 @@ const (
 	B2CStatus_B2C_REQUEST_SUBMITED B2CStatus = 2
 	B2CStatus_B2C_SUCCESS          B2CStatus = 3
 	B2CStatus_B2C_FAILED           B2CStatus = 4
+	B2CStatus_B2C_TIMED_OUT        B2CStatus = 5
+	B2CStatus_B2C_REVERSED         B2CStatus = 6
+	B2CStatus_B2C_REVERSAL_PENDING B2CStatus = 7
 )
 
 // Enum value maps for B2CStatus.
@@ -93,6 +146,9 @@ var (
 		2: "B2C_REQUEST_SUBMITED",
 		3: "B2C_SUCCESS",
 		4: "B2C_FAILED",
+		5: "B2C_TIMED_OUT",
+		6: "B2C_REVERSED",
+		7: "B2C_REVERSAL_PENDING",
 	}
 	B2CStatus_value = map[string]int32{
 		"B2C_STATUS_UNKNOWN":   0,
@@ -100,6 +156,9 @@ var (
 		"B2C_REQUEST_SUBMITED": 2,
 		"B2C_SUCCESS":          3,
 		"B2C_FAILED":           4,
+		"B2C_TIMED_OUT":        5,
+		"B2C_REVERSED":         6,
+		"B2C_REVERSAL_PENDING": 7,
 	}
 )
 
@@ -114,11 +173,11 @@ func (x B2CStatus) String() string {
 }
 
 func (B2CStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_b2c_v1_proto_enumTypes[1].Descriptor()
+	return file_b2c_v1_proto_enumTypes[2].Descriptor()
 }
 
 func (B2CStatus) Type() protoreflect.EnumType {
-	return &file_b2c_v1_proto_enumTypes[1]
+	return &file_b2c_v1_proto_enumTypes[2]
 }
 
 func (x B2CStatus) Number() protoreflect.EnumNumber {
@@ -127,7 +186,7 @@ func (x B2CStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use B2CStatus.Descriptor instead.
 func (B2CStatus) EnumDescriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{1}
+	return file_b2c_v1_proto_rawDescGZIP(), []int{2}
 }
 
 type B2CPaymentView int32
@@ -163,11 +222,11 @@ func (x B2CPaymentView) String() string {
 }
 
 func (B2CPaymentView) Descriptor() protoreflect.EnumDescriptor {
-	return file_b2c_v1_proto_enumTypes[2].Descriptor()
+	return file_b2c_v1_proto_enumTypes[3].Descriptor()
 }
 
 func (B2CPaymentView) Type() protoreflect.EnumType {
-	return &file_b2c_v1_proto_enumTypes[2]
+	return &file_b2c_v1_proto_enumTypes[3]
 }
 
 func (x B2CPaymentView) Number() protoreflect.EnumNumber {
@@ -176,7 +235,7 @@ func (x B2CPaymentView) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use B2CPaymentView.Descriptor instead.
 func (B2CPaymentView) EnumDescriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{2}
+	return file_b2c_v1_proto_rawDescGZIP(), []int{3}
 }
 
 type B2COrderField int32
@@ -185,6 +244,7 @@ const (
 	B2COrderField_B2C_ORDER_FIELD_UNSPECIFIED B2COrderField = 0
 	B2COrderField_B2C_PAYMENT_ID              B2COrderField = 1
 	B2COrderField_B2C_TRANSACTION_TIMESTAMP   B2COrderField = 2
+	B2COrderField_B2C_AMOUNT                  B2COrderField = 3
 )
 
 // Enum value maps for B2COrderField.
@@ -193,11 +253,13 @@ var (
 		0: "B2C_ORDER_FIELD_UNSPECIFIED",
 		1: "B2C_PAYMENT_ID",
 		2: "B2C_TRANSACTION_TIMESTAMP",
+		3: "B2C_AMOUNT",
 	}
 	B2COrderField_value = map[string]int32{
 		"B2C_ORDER_FIELD_UNSPECIFIED": 0,
 		"B2C_PAYMENT_ID":              1,
 		"B2C_TRANSACTION_TIMESTAMP":   2,
+		"B2C_AMOUNT":                  3,
 	}
 )
 
@@ -212,11 +274,11 @@ func (x B2COrderField) String() string {
 }
 
 func (B2COrderField) Descriptor() protoreflect.EnumDescriptor {
-	return file_b2c_v1_proto_enumTypes[3].Descriptor()
+	return file_b2c_v1_proto_enumTypes[4].Descriptor()
 }
 
 func (B2COrderField) Type() protoreflect.EnumType {
-	return &file_b2c_v1_proto_enumTypes[3]
+	return &file_b2c_v1_proto_enumTypes[4]
 }
 
 func (x B2COrderField) Number() protoreflect.EnumNumber {
@@ -225,7 +287,154 @@ func (x B2COrderField) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use B2COrderField.Descriptor instead.
 func (B2COrderField) EnumDescriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{3}
+	return file_b2c_v1_proto_rawDescGZIP(), []int{4}
+}
+
+type B2COrderDirection int32
+
+const (
+	B2COrderDirection_B2C_ORDER_DIRECTION_UNSPECIFIED B2COrderDirection = 0
+	B2COrderDirection_B2C_ORDER_DESC                  B2COrderDirection = 1
+	B2COrderDirection_B2C_ORDER_ASC                   B2COrderDirection = 2
+)
+
+// Enum value maps for B2COrderDirection.
+var (
+	B2COrderDirection_name = map[int32]string{
+		0: "B2C_ORDER_DIRECTION_UNSPECIFIED",
+		1: "B2C_ORDER_DESC",
+		2: "B2C_ORDER_ASC",
+	}
+	B2COrderDirection_value = map[string]int32{
+		"B2C_ORDER_DIRECTION_UNSPECIFIED": 0,
+		"B2C_ORDER_DESC":                  1,
+		"B2C_ORDER_ASC":                   2,
+	}
+)
+
+func (x B2COrderDirection) Enum() *B2COrderDirection {
+	p := new(B2COrderDirection)
+	*p = x
+	return p
+}
+
+func (x B2COrderDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (B2COrderDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[5].Descriptor()
+}
+
+func (B2COrderDirection) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[5]
+}
+
+func (x B2COrderDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use B2COrderDirection.Descriptor instead.
+func (B2COrderDirection) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{5}
+}
+
+type B2CSucceededState int32
+
+const (
+	B2CSucceededState_B2C_SUCCEEDED_STATE_UNSPECIFIED B2CSucceededState = 0
+	B2CSucceededState_B2C_SUCCEEDED                   B2CSucceededState = 1
+	B2CSucceededState_B2C_NOT_SUCCEEDED               B2CSucceededState = 2
+)
+
+// Enum value maps for B2CSucceededState.
+var (
+	B2CSucceededState_name = map[int32]string{
+		0: "B2C_SUCCEEDED_STATE_UNSPECIFIED",
+		1: "B2C_SUCCEEDED",
+		2: "B2C_NOT_SUCCEEDED",
+	}
+	B2CSucceededState_value = map[string]int32{
+		"B2C_SUCCEEDED_STATE_UNSPECIFIED": 0,
+		"B2C_SUCCEEDED":                   1,
+		"B2C_NOT_SUCCEEDED":               2,
+	}
+)
+
+func (x B2CSucceededState) Enum() *B2CSucceededState {
+	p := new(B2CSucceededState)
+	*p = x
+	return p
+}
+
+func (x B2CSucceededState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (B2CSucceededState) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[6].Descriptor()
+}
+
+func (B2CSucceededState) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[6]
+}
+
+func (x B2CSucceededState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use B2CSucceededState.Descriptor instead.
+func (B2CSucceededState) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{6}
+}
+
+type B2CRecipientState int32
+
+const (
+	B2CRecipientState_B2C_RECIPIENT_STATE_UNSPECIFIED B2CRecipientState = 0
+	B2CRecipientState_B2C_RECIPIENT_REGISTERED        B2CRecipientState = 1
+	B2CRecipientState_B2C_RECIPIENT_NOT_REGISTERED    B2CRecipientState = 2
+)
+
+// Enum value maps for B2CRecipientState.
+var (
+	B2CRecipientState_name = map[int32]string{
+		0: "B2C_RECIPIENT_STATE_UNSPECIFIED",
+		1: "B2C_RECIPIENT_REGISTERED",
+		2: "B2C_RECIPIENT_NOT_REGISTERED",
+	}
+	B2CRecipientState_value = map[string]int32{
+		"B2C_RECIPIENT_STATE_UNSPECIFIED": 0,
+		"B2C_RECIPIENT_REGISTERED":        1,
+		"B2C_RECIPIENT_NOT_REGISTERED":    2,
+	}
+)
+
+func (x B2CRecipientState) Enum() *B2CRecipientState {
+	p := new(B2CRecipientState)
+	*p = x
+	return p
+}
+
+func (x B2CRecipientState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (B2CRecipientState) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[7].Descriptor()
+}
+
+func (B2CRecipientState) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[7]
+}
+
+func (x B2CRecipientState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use B2CRecipientState.Descriptor instead.
+func (B2CRecipientState) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{7}
 }
 
 type B2CProcessedState int32
@@ -261,11 +470,11 @@ func (x B2CProcessedState) String() string {
 }
 
 func (B2CProcessedState) Descriptor() protoreflect.EnumDescriptor {
-	return file_b2c_v1_proto_enumTypes[4].Descriptor()
+	return file_b2c_v1_proto_enumTypes[8].Descriptor()
 }
 
 func (B2CProcessedState) Type() protoreflect.EnumType {
-	return &file_b2c_v1_proto_enumTypes[4]
+	return &file_b2c_v1_proto_enumTypes[8]
 }
 
 func (x B2CProcessedState) Number() protoreflect.EnumNumber {
@@ -274,497 +483,586 @@ func (x B2CProcessedState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use B2CProcessedState.Descriptor instead.
 func (B2CProcessedState) EnumDescriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{4}
+	return file_b2c_v1_proto_rawDescGZIP(), []int{8}
 }
 
-type QueryTransactionStatusRequest_IdentifierType int32
+type AggregateGroupBy int32
 
 const (
-	QueryTransactionStatusRequest_QUERY_TRANSACTION_UNSPECIFIED QueryTransactionStatusRequest_IdentifierType = 0
-	QueryTransactionStatusRequest_MSISDN                        QueryTransactionStatusRequest_IdentifierType = 1
-	QueryTransactionStatusRequest_TILL_NUMBER                   QueryTransactionStatusRequest_IdentifierType = 2
-	QueryTransactionStatusRequest_ORGANIZATION_SHORT_CODE       QueryTransactionStatusRequest_IdentifierType = 3
+	AggregateGroupBy_AGGREGATE_GROUP_BY_UNSPECIFIED AggregateGroupBy = 0
+	AggregateGroupBy_AGGREGATE_GROUP_BY_SHORT_CODE  AggregateGroupBy = 1
+	AggregateGroupBy_AGGREGATE_GROUP_BY_INITIATOR   AggregateGroupBy = 2
+	AggregateGroupBy_AGGREGATE_GROUP_BY_COMMAND_ID  AggregateGroupBy = 3
+	AggregateGroupBy_AGGREGATE_GROUP_BY_STATUS      AggregateGroupBy = 4
+	AggregateGroupBy_AGGREGATE_GROUP_BY_MSISDN      AggregateGroupBy = 5
 )
 
-// Enum value maps for QueryTransactionStatusRequest_IdentifierType.
+// Enum value maps for AggregateGroupBy.
 var (
-	QueryTransactionStatusRequest_IdentifierType_name = map[int32]string{
-		0: "QUERY_TRANSACTION_UNSPECIFIED",
-		1: "MSISDN",
-		2: "TILL_NUMBER",
-		3: "ORGANIZATION_SHORT_CODE",
-	}
-	QueryTransactionStatusRequest_IdentifierType_value = map[string]int32{
-		"QUERY_TRANSACTION_UNSPECIFIED": 0,
-		"MSISDN":                        1,
-		"TILL_NUMBER":                   2,
-		"ORGANIZATION_SHORT_CODE":       3,
+	AggregateGroupBy_name = map[int32]string{
+		0: "AGGREGATE_GROUP_BY_UNSPECIFIED",
+		1: "AGGREGATE_GROUP_BY_SHORT_CODE",
+		2: "AGGREGATE_GROUP_BY_INITIATOR",
+		3: "AGGREGATE_GROUP_BY_COMMAND_ID",
+		4: "AGGREGATE_GROUP_BY_STATUS",
+		5: "AGGREGATE_GROUP_BY_MSISDN",
+	}
+	AggregateGroupBy_value = map[string]int32{
+		"AGGREGATE_GROUP_BY_UNSPECIFIED": 0,
+		"AGGREGATE_GROUP_BY_SHORT_CODE":  1,
+		"AGGREGATE_GROUP_BY_INITIATOR":   2,
+		"AGGREGATE_GROUP_BY_COMMAND_ID":  3,
+		"AGGREGATE_GROUP_BY_STATUS":      4,
+		"AGGREGATE_GROUP_BY_MSISDN":      5,
 	}
 )
 
-func (x QueryTransactionStatusRequest_IdentifierType) Enum() *QueryTransactionStatusRequest_IdentifierType {
-	p := new(QueryTransactionStatusRequest_IdentifierType)
+func (x AggregateGroupBy) Enum() *AggregateGroupBy {
+	p := new(AggregateGroupBy)
 	*p = x
 	return p
 }
 
-func (x QueryTransactionStatusRequest_IdentifierType) String() string {
+func (x AggregateGroupBy) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (QueryTransactionStatusRequest_IdentifierType) Descriptor() protoreflect.EnumDescriptor {
-	return file_b2c_v1_proto_enumTypes[5].Descriptor()
+func (AggregateGroupBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[9].Descriptor()
 }
 
-func (QueryTransactionStatusRequest_IdentifierType) Type() protoreflect.EnumType {
-	return &file_b2c_v1_proto_enumTypes[5]
+func (AggregateGroupBy) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[9]
 }
 
-func (x QueryTransactionStatusRequest_IdentifierType) Number() protoreflect.EnumNumber {
+func (x AggregateGroupBy) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use QueryTransactionStatusRequest_IdentifierType.Descriptor instead.
-func (QueryTransactionStatusRequest_IdentifierType) EnumDescriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{15, 0}
+// Deprecated: Use AggregateGroupBy.Descriptor instead.
+func (AggregateGroupBy) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{9}
 }
 
-type QueryAccountBalanceRequest_IdentifierType int32
+type TimeBucket int32
 
 const (
-	QueryAccountBalanceRequest_QUERY_ACCOUNT_UNSPECIFIED QueryAccountBalanceRequest_IdentifierType = 0
-	QueryAccountBalanceRequest_MSISDN                    QueryAccountBalanceRequest_IdentifierType = 1
-	QueryAccountBalanceRequest_TILL_NUMBER               QueryAccountBalanceRequest_IdentifierType = 2
-	QueryAccountBalanceRequest_ORGANIZATION_SHORT_CODE   QueryAccountBalanceRequest_IdentifierType = 3
+	TimeBucket_TIME_BUCKET_UNSPECIFIED TimeBucket = 0
+	TimeBucket_TIME_BUCKET_HOUR        TimeBucket = 1
+	TimeBucket_TIME_BUCKET_DAY         TimeBucket = 2
+	TimeBucket_TIME_BUCKET_WEEK        TimeBucket = 3
+	TimeBucket_TIME_BUCKET_MONTH       TimeBucket = 4
 )
 
-// Enum value maps for QueryAccountBalanceRequest_IdentifierType.
+// Enum value maps for TimeBucket.
 var (
-	QueryAccountBalanceRequest_IdentifierType_name = map[int32]string{
-		0: "QUERY_ACCOUNT_UNSPECIFIED",
-		1: "MSISDN",
-		2: "TILL_NUMBER",
-		3: "ORGANIZATION_SHORT_CODE",
-	}
-	QueryAccountBalanceRequest_IdentifierType_value = map[string]int32{
-		"QUERY_ACCOUNT_UNSPECIFIED": 0,
-		"MSISDN":                    1,
-		"TILL_NUMBER":               2,
-		"ORGANIZATION_SHORT_CODE":   3,
+	TimeBucket_name = map[int32]string{
+		0: "TIME_BUCKET_UNSPECIFIED",
+		1: "TIME_BUCKET_HOUR",
+		2: "TIME_BUCKET_DAY",
+		3: "TIME_BUCKET_WEEK",
+		4: "TIME_BUCKET_MONTH",
+	}
+	TimeBucket_value = map[string]int32{
+		"TIME_BUCKET_UNSPECIFIED": 0,
+		"TIME_BUCKET_HOUR":        1,
+		"TIME_BUCKET_DAY":         2,
+		"TIME_BUCKET_WEEK":        3,
+		"TIME_BUCKET_MONTH":       4,
 	}
 )
 
-func (x QueryAccountBalanceRequest_IdentifierType) Enum() *QueryAccountBalanceRequest_IdentifierType {
-	p := new(QueryAccountBalanceRequest_IdentifierType)
+func (x TimeBucket) Enum() *TimeBucket {
+	p := new(TimeBucket)
 	*p = x
 	return p
 }
 
-func (x QueryAccountBalanceRequest_IdentifierType) String() string {
+func (x TimeBucket) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (QueryAccountBalanceRequest_IdentifierType) Descriptor() protoreflect.EnumDescriptor {
-	return file_b2c_v1_proto_enumTypes[6].Descriptor()
+func (TimeBucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[10].Descriptor()
 }
 
-func (QueryAccountBalanceRequest_IdentifierType) Type() protoreflect.EnumType {
-	return &file_b2c_v1_proto_enumTypes[6]
+func (TimeBucket) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[10]
 }
 
-func (x QueryAccountBalanceRequest_IdentifierType) Number() protoreflect.EnumNumber {
+func (x TimeBucket) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use QueryAccountBalanceRequest_IdentifierType.Descriptor instead.
-func (QueryAccountBalanceRequest_IdentifierType) EnumDescriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use TimeBucket.Descriptor instead.
+func (TimeBucket) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{10}
 }
 
-type TransferFundsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ExportFormat int32
 
-	InitiatorId                string       `protobuf:"bytes,1,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	InitiatorCustomerReference string       `protobuf:"bytes,2,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
-	InitiatorCustomerNames     string       `protobuf:"bytes,3,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
-	Msisdn                     string       `protobuf:"bytes,4,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
-	Amount                     float64      `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	ShortCode                  string       `protobuf:"bytes,6,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	Remarks                    string       `protobuf:"bytes,7,opt,name=remarks,proto3" json:"remarks,omitempty"`
-	Occassion                  string       `protobuf:"bytes,8,opt,name=occassion,proto3" json:"occassion,omitempty"`
-	CommandId                  CommandId    `protobuf:"varint,9,opt,name=command_id,json=commandId,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_id,omitempty"`
-	Publish                    bool         `protobuf:"varint,10,opt,name=publish,proto3" json:"publish,omitempty"`
-	PublishMessage             *PublishInfo `protobuf:"bytes,11,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
-}
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	// Limited to 1048575 payments, the rows of a sheet below its header
+	ExportFormat_EXPORT_FORMAT_XLSX ExportFormat = 2
+)
 
-func (x *TransferFundsRequest) Reset() {
-	*x = TransferFundsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_XLSX",
 	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_XLSX":        2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
 }
 
-func (x *TransferFundsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*TransferFundsRequest) ProtoMessage() {}
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[11].Descriptor()
+}
 
-func (x *TransferFundsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[11]
 }
 
-// Deprecated: Use TransferFundsRequest.ProtoReflect.Descriptor instead.
-func (*TransferFundsRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{0}
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TransferFundsRequest) GetInitiatorId() string {
-	if x != nil {
-		return x.InitiatorId
-	}
-	return ""
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TransferFundsRequest) GetInitiatorCustomerReference() string {
-	if x != nil {
-		return x.InitiatorCustomerReference
+type StatsJobStatus int32
+
+const (
+	StatsJobStatus_STATS_JOB_STATUS_UNSPECIFIED StatsJobStatus = 0
+	StatsJobStatus_STATS_JOB_PENDING            StatsJobStatus = 1
+	StatsJobStatus_STATS_JOB_RUNNING            StatsJobStatus = 2
+	StatsJobStatus_STATS_JOB_SUCCEEDED          StatsJobStatus = 3
+	StatsJobStatus_STATS_JOB_FAILED             StatsJobStatus = 4
+)
+
+// Enum value maps for StatsJobStatus.
+var (
+	StatsJobStatus_name = map[int32]string{
+		0: "STATS_JOB_STATUS_UNSPECIFIED",
+		1: "STATS_JOB_PENDING",
+		2: "STATS_JOB_RUNNING",
+		3: "STATS_JOB_SUCCEEDED",
+		4: "STATS_JOB_FAILED",
+	}
+	StatsJobStatus_value = map[string]int32{
+		"STATS_JOB_STATUS_UNSPECIFIED": 0,
+		"STATS_JOB_PENDING":            1,
+		"STATS_JOB_RUNNING":            2,
+		"STATS_JOB_SUCCEEDED":          3,
+		"STATS_JOB_FAILED":             4,
 	}
-	return ""
+)
+
+func (x StatsJobStatus) Enum() *StatsJobStatus {
+	p := new(StatsJobStatus)
+	*p = x
+	return p
 }
 
-func (x *TransferFundsRequest) GetInitiatorCustomerNames() string {
-	if x != nil {
-		return x.InitiatorCustomerNames
-	}
-	return ""
+func (x StatsJobStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TransferFundsRequest) GetMsisdn() string {
-	if x != nil {
-		return x.Msisdn
-	}
-	return ""
+func (StatsJobStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[12].Descriptor()
 }
 
-func (x *TransferFundsRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (StatsJobStatus) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[12]
 }
 
-func (x *TransferFundsRequest) GetShortCode() string {
-	if x != nil {
-		return x.ShortCode
-	}
-	return ""
+func (x StatsJobStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TransferFundsRequest) GetRemarks() string {
-	if x != nil {
-		return x.Remarks
-	}
-	return ""
+// Deprecated: Use StatsJobStatus.Descriptor instead.
+func (StatsJobStatus) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TransferFundsRequest) GetOccassion() string {
-	if x != nil {
-		return x.Occassion
-	}
-	return ""
-}
+type B2CEventType int32
 
-func (x *TransferFundsRequest) GetCommandId() CommandId {
-	if x != nil {
-		return x.CommandId
-	}
-	return CommandId_COMMANDID_UNSPECIFIED
-}
+const (
+	B2CEventType_B2C_EVENT_TYPE_UNSPECIFIED B2CEventType = 0
+	B2CEventType_B2C_PAYMENT_SUCCEEDED      B2CEventType = 1
+	B2CEventType_B2C_PAYMENT_FAILED         B2CEventType = 2
+	B2CEventType_B2C_PAYMENT_SUBMITTED      B2CEventType = 3
+	B2CEventType_B2C_PAYMENT_SUBMIT_FAILED  B2CEventType = 4
+	B2CEventType_B2C_PAYMENT_TIMED_OUT      B2CEventType = 5
+	B2CEventType_B2C_PAYMENT_REVERSED       B2CEventType = 6
+	B2CEventType_B2C_PAYMENT_PROCESSED      B2CEventType = 7
+)
 
-func (x *TransferFundsRequest) GetPublish() bool {
-	if x != nil {
-		return x.Publish
+// Enum value maps for B2CEventType.
+var (
+	B2CEventType_name = map[int32]string{
+		0: "B2C_EVENT_TYPE_UNSPECIFIED",
+		1: "B2C_PAYMENT_SUCCEEDED",
+		2: "B2C_PAYMENT_FAILED",
+		3: "B2C_PAYMENT_SUBMITTED",
+		4: "B2C_PAYMENT_SUBMIT_FAILED",
+		5: "B2C_PAYMENT_TIMED_OUT",
+		6: "B2C_PAYMENT_REVERSED",
+		7: "B2C_PAYMENT_PROCESSED",
+	}
+	B2CEventType_value = map[string]int32{
+		"B2C_EVENT_TYPE_UNSPECIFIED": 0,
+		"B2C_PAYMENT_SUCCEEDED":      1,
+		"B2C_PAYMENT_FAILED":         2,
+		"B2C_PAYMENT_SUBMITTED":      3,
+		"B2C_PAYMENT_SUBMIT_FAILED":  4,
+		"B2C_PAYMENT_TIMED_OUT":      5,
+		"B2C_PAYMENT_REVERSED":       6,
+		"B2C_PAYMENT_PROCESSED":      7,
 	}
-	return false
+)
+
+func (x B2CEventType) Enum() *B2CEventType {
+	p := new(B2CEventType)
+	*p = x
+	return p
 }
 
-func (x *TransferFundsRequest) GetPublishMessage() *PublishInfo {
-	if x != nil {
-		return x.PublishMessage
-	}
-	return nil
+func (x B2CEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type TransferFundsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (B2CEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[13].Descriptor()
+}
 
-	Progress bool   `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
-	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (B2CEventType) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[13]
 }
 
-func (x *TransferFundsResponse) Reset() {
-	*x = TransferFundsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x B2CEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TransferFundsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use B2CEventType.Descriptor instead.
+func (B2CEventType) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{13}
 }
 
-func (*TransferFundsResponse) ProtoMessage() {}
+type WebhookDeliveryStatus int32
 
-func (x *TransferFundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_PENDING            WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_DELIVERED          WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_FAILED             WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_PENDING",
+		2: "WEBHOOK_DELIVERY_DELIVERED",
+		3: "WEBHOOK_DELIVERY_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_PENDING":            1,
+		"WEBHOOK_DELIVERY_DELIVERED":          2,
+		"WEBHOOK_DELIVERY_FAILED":             3,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use TransferFundsResponse.ProtoReflect.Descriptor instead.
-func (*TransferFundsResponse) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{1}
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
 }
 
-func (x *TransferFundsResponse) GetProgress() bool {
-	if x != nil {
-		return x.Progress
-	}
-	return false
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *TransferFundsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[14].Descriptor()
 }
 
-type PublishMessage struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TransactionId  uint64       `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	InitiatorId    string       `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	MpesaReceiptId string       `protobuf:"bytes,3,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
-	Msisdn         string       `protobuf:"bytes,4,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
-	PublishInfo    *PublishInfo `protobuf:"bytes,5,opt,name=publish_info,json=publishInfo,proto3" json:"publish_info,omitempty"`
-	Payment        *B2CPayment  `protobuf:"bytes,6,opt,name=payment,proto3" json:"payment,omitempty"`
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[14]
 }
 
-func (x *PublishMessage) Reset() {
-	*x = PublishMessage{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PublishMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{14}
 }
 
-func (*PublishMessage) ProtoMessage() {}
+type ArchivalRunStatus int32
 
-func (x *PublishMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ArchivalRunStatus_ARCHIVAL_RUN_STATUS_UNSPECIFIED ArchivalRunStatus = 0
+	ArchivalRunStatus_ARCHIVAL_RUN_RUNNING            ArchivalRunStatus = 1
+	ArchivalRunStatus_ARCHIVAL_RUN_SUCCEEDED          ArchivalRunStatus = 2
+	ArchivalRunStatus_ARCHIVAL_RUN_FAILED             ArchivalRunStatus = 3
+)
+
+// Enum value maps for ArchivalRunStatus.
+var (
+	ArchivalRunStatus_name = map[int32]string{
+		0: "ARCHIVAL_RUN_STATUS_UNSPECIFIED",
+		1: "ARCHIVAL_RUN_RUNNING",
+		2: "ARCHIVAL_RUN_SUCCEEDED",
+		3: "ARCHIVAL_RUN_FAILED",
+	}
+	ArchivalRunStatus_value = map[string]int32{
+		"ARCHIVAL_RUN_STATUS_UNSPECIFIED": 0,
+		"ARCHIVAL_RUN_RUNNING":            1,
+		"ARCHIVAL_RUN_SUCCEEDED":          2,
+		"ARCHIVAL_RUN_FAILED":             3,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x ArchivalRunStatus) Enum() *ArchivalRunStatus {
+	p := new(ArchivalRunStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use PublishMessage.ProtoReflect.Descriptor instead.
-func (*PublishMessage) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{2}
+func (x ArchivalRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PublishMessage) GetTransactionId() uint64 {
-	if x != nil {
-		return x.TransactionId
-	}
-	return 0
+func (ArchivalRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[15].Descriptor()
 }
 
-func (x *PublishMessage) GetInitiatorId() string {
-	if x != nil {
-		return x.InitiatorId
-	}
-	return ""
+func (ArchivalRunStatus) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[15]
 }
 
-func (x *PublishMessage) GetMpesaReceiptId() string {
-	if x != nil {
-		return x.MpesaReceiptId
-	}
-	return ""
+func (x ArchivalRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PublishMessage) GetMsisdn() string {
-	if x != nil {
-		return x.Msisdn
-	}
-	return ""
+// Deprecated: Use ArchivalRunStatus.Descriptor instead.
+func (ArchivalRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PublishMessage) GetPublishInfo() *PublishInfo {
-	if x != nil {
-		return x.PublishInfo
+type ArchiveTarget int32
+
+const (
+	ArchiveTarget_ARCHIVE_TARGET_UNSPECIFIED ArchiveTarget = 0
+	// Payments are moved to the archive table
+	ArchiveTarget_ARCHIVE_TABLE ArchiveTarget = 1
+	// Payments are written to compressed NDJSON files on local disk
+	ArchiveTarget_ARCHIVE_FILES ArchiveTarget = 2
+)
+
+// Enum value maps for ArchiveTarget.
+var (
+	ArchiveTarget_name = map[int32]string{
+		0: "ARCHIVE_TARGET_UNSPECIFIED",
+		1: "ARCHIVE_TABLE",
+		2: "ARCHIVE_FILES",
 	}
-	return nil
+	ArchiveTarget_value = map[string]int32{
+		"ARCHIVE_TARGET_UNSPECIFIED": 0,
+		"ARCHIVE_TABLE":              1,
+		"ARCHIVE_FILES":              2,
+	}
+)
+
+func (x ArchiveTarget) Enum() *ArchiveTarget {
+	p := new(ArchiveTarget)
+	*p = x
+	return p
 }
 
-func (x *PublishMessage) GetPayment() *B2CPayment {
-	if x != nil {
-		return x.Payment
-	}
-	return nil
+func (x ArchiveTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type PublishInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ArchiveTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[16].Descriptor()
+}
 
-	OnlyOnSuccess bool              `protobuf:"varint,1,opt,name=only_on_success,json=onlyOnSuccess,proto3" json:"only_on_success,omitempty"`
-	ChannelName   string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
-	Payload       map[string]string `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (ArchiveTarget) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[16]
 }
 
-func (x *PublishInfo) Reset() {
-	*x = PublishInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ArchiveTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PublishInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ArchiveTarget.Descriptor instead.
+func (ArchiveTarget) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{16}
 }
 
-func (*PublishInfo) ProtoMessage() {}
+type QueryTransactionStatusRequest_IdentifierType int32
 
-func (x *PublishInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	QueryTransactionStatusRequest_QUERY_TRANSACTION_UNSPECIFIED QueryTransactionStatusRequest_IdentifierType = 0
+	QueryTransactionStatusRequest_MSISDN                        QueryTransactionStatusRequest_IdentifierType = 1
+	QueryTransactionStatusRequest_TILL_NUMBER                   QueryTransactionStatusRequest_IdentifierType = 2
+	QueryTransactionStatusRequest_ORGANIZATION_SHORT_CODE       QueryTransactionStatusRequest_IdentifierType = 3
+)
+
+// Enum value maps for QueryTransactionStatusRequest_IdentifierType.
+var (
+	QueryTransactionStatusRequest_IdentifierType_name = map[int32]string{
+		0: "QUERY_TRANSACTION_UNSPECIFIED",
+		1: "MSISDN",
+		2: "TILL_NUMBER",
+		3: "ORGANIZATION_SHORT_CODE",
 	}
-	return mi.MessageOf(x)
+	QueryTransactionStatusRequest_IdentifierType_value = map[string]int32{
+		"QUERY_TRANSACTION_UNSPECIFIED": 0,
+		"MSISDN":                        1,
+		"TILL_NUMBER":                   2,
+		"ORGANIZATION_SHORT_CODE":       3,
+	}
+)
+
+func (x QueryTransactionStatusRequest_IdentifierType) Enum() *QueryTransactionStatusRequest_IdentifierType {
+	p := new(QueryTransactionStatusRequest_IdentifierType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use PublishInfo.ProtoReflect.Descriptor instead.
-func (*PublishInfo) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{3}
+func (x QueryTransactionStatusRequest_IdentifierType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PublishInfo) GetOnlyOnSuccess() bool {
-	if x != nil {
-		return x.OnlyOnSuccess
-	}
-	return false
+func (QueryTransactionStatusRequest_IdentifierType) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[17].Descriptor()
 }
 
-func (x *PublishInfo) GetChannelName() string {
-	if x != nil {
-		return x.ChannelName
-	}
-	return ""
+func (QueryTransactionStatusRequest_IdentifierType) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[17]
 }
 
-func (x *PublishInfo) GetPayload() map[string]string {
-	if x != nil {
-		return x.Payload
+func (x QueryTransactionStatusRequest_IdentifierType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryTransactionStatusRequest_IdentifierType.Descriptor instead.
+func (QueryTransactionStatusRequest_IdentifierType) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type QueryAccountBalanceRequest_IdentifierType int32
+
+const (
+	QueryAccountBalanceRequest_QUERY_ACCOUNT_UNSPECIFIED QueryAccountBalanceRequest_IdentifierType = 0
+	QueryAccountBalanceRequest_MSISDN                    QueryAccountBalanceRequest_IdentifierType = 1
+	QueryAccountBalanceRequest_TILL_NUMBER               QueryAccountBalanceRequest_IdentifierType = 2
+	QueryAccountBalanceRequest_ORGANIZATION_SHORT_CODE   QueryAccountBalanceRequest_IdentifierType = 3
+)
+
+// Enum value maps for QueryAccountBalanceRequest_IdentifierType.
+var (
+	QueryAccountBalanceRequest_IdentifierType_name = map[int32]string{
+		0: "QUERY_ACCOUNT_UNSPECIFIED",
+		1: "MSISDN",
+		2: "TILL_NUMBER",
+		3: "ORGANIZATION_SHORT_CODE",
 	}
-	return nil
+	QueryAccountBalanceRequest_IdentifierType_value = map[string]int32{
+		"QUERY_ACCOUNT_UNSPECIFIED": 0,
+		"MSISDN":                    1,
+		"TILL_NUMBER":               2,
+		"ORGANIZATION_SHORT_CODE":   3,
+	}
+)
+
+func (x QueryAccountBalanceRequest_IdentifierType) Enum() *QueryAccountBalanceRequest_IdentifierType {
+	p := new(QueryAccountBalanceRequest_IdentifierType)
+	*p = x
+	return p
 }
 
-type B2CPayment struct {
+func (x QueryAccountBalanceRequest_IdentifierType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryAccountBalanceRequest_IdentifierType) Descriptor() protoreflect.EnumDescriptor {
+	return file_b2c_v1_proto_enumTypes[18].Descriptor()
+}
+
+func (QueryAccountBalanceRequest_IdentifierType) Type() protoreflect.EnumType {
+	return &file_b2c_v1_proto_enumTypes[18]
+}
+
+func (x QueryAccountBalanceRequest_IdentifierType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryAccountBalanceRequest_IdentifierType.Descriptor instead.
+func (QueryAccountBalanceRequest_IdentifierType) EnumDescriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{34, 0}
+}
+
+type TransferFundsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransactionId              uint64    `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	InitiatorId                string    `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	InitiatorCustomerReference string    `protobuf:"bytes,3,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
-	InitiatorCustomerNames     string    `protobuf:"bytes,4,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
-	OrgShortCode               string    `protobuf:"bytes,5,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
-	CommandId                  CommandId `protobuf:"varint,6,opt,name=command_id,json=commandId,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_id,omitempty"`
-	Msisdn                     string    `protobuf:"bytes,7,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
-	Amount                     float32   `protobuf:"fixed32,8,opt,name=amount,proto3" json:"amount,omitempty"`
-	ConversationId             string    `protobuf:"bytes,9,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
-	OriginalConversationId     string    `protobuf:"bytes,10,opt,name=original_conversation_id,json=originalConversationId,proto3" json:"original_conversation_id,omitempty"`
-	B2CResponseDescription     string    `protobuf:"bytes,11,opt,name=b2c_response_description,json=b2cResponseDescription,proto3" json:"b2c_response_description,omitempty"`
-	B2CResponseCode            string    `protobuf:"bytes,12,opt,name=b2c_response_code,json=b2cResponseCode,proto3" json:"b2c_response_code,omitempty"`
-	B2CResultDescription       string    `protobuf:"bytes,13,opt,name=b2c_result_description,json=b2cResultDescription,proto3" json:"b2c_result_description,omitempty"`
-	B2CResultCode              string    `protobuf:"bytes,14,opt,name=b2c_result_code,json=b2cResultCode,proto3" json:"b2c_result_code,omitempty"`
-	ReceiverPartyPublicName    string    `protobuf:"bytes,15,opt,name=receiver_party_public_name,json=receiverPartyPublicName,proto3" json:"receiver_party_public_name,omitempty"`
-	MpesaReceiptId             string    `protobuf:"bytes,16,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
-	WorkingAccountFunds        float32   `protobuf:"fixed32,17,opt,name=working_account_funds,json=workingAccountFunds,proto3" json:"working_account_funds,omitempty"`
-	UtilityAccountFunds        float32   `protobuf:"fixed32,18,opt,name=utility_account_funds,json=utilityAccountFunds,proto3" json:"utility_account_funds,omitempty"`
-	MpesaCharges               float32   `protobuf:"fixed32,19,opt,name=mpesa_charges,json=mpesaCharges,proto3" json:"mpesa_charges,omitempty"`
-	SystemCharges              float32   `protobuf:"fixed32,20,opt,name=system_charges,json=systemCharges,proto3" json:"system_charges,omitempty"`
-	RecipientRegistered        bool      `protobuf:"varint,21,opt,name=recipient_registered,json=recipientRegistered,proto3" json:"recipient_registered,omitempty"`
-	B2CStatus                  B2CStatus `protobuf:"varint,22,opt,name=b2c_status,json=b2cStatus,proto3,enum=gidyon.mpesa.b2c.B2CStatus" json:"b2c_status,omitempty"`
-	Source                     string    `protobuf:"bytes,23,opt,name=source,proto3" json:"source,omitempty"`
-	Tag                        string    `protobuf:"bytes,24,opt,name=tag,proto3" json:"tag,omitempty"`
-	Succeeded                  bool      `protobuf:"varint,25,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
-	Processed                  bool      `protobuf:"varint,26,opt,name=processed,proto3" json:"processed,omitempty"`
-	TransactionTimestamp       int64     `protobuf:"varint,27,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
-	CreateDate                 string    `protobuf:"bytes,28,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	InitiatorId                   string       `protobuf:"bytes,1,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	InitiatorCustomerReference    string       `protobuf:"bytes,2,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
+	InitiatorCustomerNames        string       `protobuf:"bytes,3,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
+	Msisdn                        string       `protobuf:"bytes,4,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	Amount                        float64      `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ShortCode                     string       `protobuf:"bytes,6,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	Remarks                       string       `protobuf:"bytes,7,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	Occassion                     string       `protobuf:"bytes,8,opt,name=occassion,proto3" json:"occassion,omitempty"`
+	CommandId                     CommandId    `protobuf:"varint,9,opt,name=command_id,json=commandId,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_id,omitempty"`
+	Publish                       bool         `protobuf:"varint,10,opt,name=publish,proto3" json:"publish,omitempty"`
+	PublishMessage                *PublishInfo `protobuf:"bytes,11,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
+	InitiatorTransactionReference string       `protobuf:"bytes,12,opt,name=initiator_transaction_reference,json=initiatorTransactionReference,proto3" json:"initiator_transaction_reference,omitempty"`
 }
 
-func (x *B2CPayment) Reset() {
-	*x = B2CPayment{}
+func (x *TransferFundsRequest) Reset() {
+	*x = TransferFundsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[4]
+		mi := &file_b2c_v1_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *B2CPayment) String() string {
+func (x *TransferFundsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*B2CPayment) ProtoMessage() {}
+func (*TransferFundsRequest) ProtoMessage() {}
 
-func (x *B2CPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[4]
+func (x *TransferFundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,234 +1073,181 @@ func (x *B2CPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use B2CPayment.ProtoReflect.Descriptor instead.
-func (*B2CPayment) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *B2CPayment) GetTransactionId() uint64 {
-	if x != nil {
-		return x.TransactionId
-	}
-	return 0
+// Deprecated: Use TransferFundsRequest.ProtoReflect.Descriptor instead.
+func (*TransferFundsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *B2CPayment) GetInitiatorId() string {
+func (x *TransferFundsRequest) GetInitiatorId() string {
 	if x != nil {
 		return x.InitiatorId
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetInitiatorCustomerReference() string {
+func (x *TransferFundsRequest) GetInitiatorCustomerReference() string {
 	if x != nil {
 		return x.InitiatorCustomerReference
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetInitiatorCustomerNames() string {
+func (x *TransferFundsRequest) GetInitiatorCustomerNames() string {
 	if x != nil {
 		return x.InitiatorCustomerNames
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetOrgShortCode() string {
-	if x != nil {
-		return x.OrgShortCode
-	}
-	return ""
-}
-
-func (x *B2CPayment) GetCommandId() CommandId {
-	if x != nil {
-		return x.CommandId
-	}
-	return CommandId_COMMANDID_UNSPECIFIED
-}
-
-func (x *B2CPayment) GetMsisdn() string {
+func (x *TransferFundsRequest) GetMsisdn() string {
 	if x != nil {
 		return x.Msisdn
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetAmount() float32 {
+func (x *TransferFundsRequest) GetAmount() float64 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *B2CPayment) GetConversationId() string {
-	if x != nil {
-		return x.ConversationId
-	}
-	return ""
-}
-
-func (x *B2CPayment) GetOriginalConversationId() string {
+func (x *TransferFundsRequest) GetShortCode() string {
 	if x != nil {
-		return x.OriginalConversationId
+		return x.ShortCode
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetB2CResponseDescription() string {
+func (x *TransferFundsRequest) GetRemarks() string {
 	if x != nil {
-		return x.B2CResponseDescription
+		return x.Remarks
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetB2CResponseCode() string {
+func (x *TransferFundsRequest) GetOccassion() string {
 	if x != nil {
-		return x.B2CResponseCode
+		return x.Occassion
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetB2CResultDescription() string {
+func (x *TransferFundsRequest) GetCommandId() CommandId {
 	if x != nil {
-		return x.B2CResultDescription
+		return x.CommandId
 	}
-	return ""
+	return CommandId_COMMANDID_UNSPECIFIED
 }
 
-func (x *B2CPayment) GetB2CResultCode() string {
+func (x *TransferFundsRequest) GetPublish() bool {
 	if x != nil {
-		return x.B2CResultCode
+		return x.Publish
 	}
-	return ""
+	return false
 }
 
-func (x *B2CPayment) GetReceiverPartyPublicName() string {
+func (x *TransferFundsRequest) GetPublishMessage() *PublishInfo {
 	if x != nil {
-		return x.ReceiverPartyPublicName
+		return x.PublishMessage
 	}
-	return ""
+	return nil
 }
 
-func (x *B2CPayment) GetMpesaReceiptId() string {
+func (x *TransferFundsRequest) GetInitiatorTransactionReference() string {
 	if x != nil {
-		return x.MpesaReceiptId
+		return x.InitiatorTransactionReference
 	}
 	return ""
 }
 
-func (x *B2CPayment) GetWorkingAccountFunds() float32 {
-	if x != nil {
-		return x.WorkingAccountFunds
-	}
-	return 0
-}
-
-func (x *B2CPayment) GetUtilityAccountFunds() float32 {
-	if x != nil {
-		return x.UtilityAccountFunds
-	}
-	return 0
-}
-
-func (x *B2CPayment) GetMpesaCharges() float32 {
-	if x != nil {
-		return x.MpesaCharges
-	}
-	return 0
-}
+type TransferFundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *B2CPayment) GetSystemCharges() float32 {
-	if x != nil {
-		return x.SystemCharges
-	}
-	return 0
+	Progress bool   `protobuf:"varint,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Message  string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *B2CPayment) GetRecipientRegistered() bool {
-	if x != nil {
-		return x.RecipientRegistered
+func (x *TransferFundsResponse) Reset() {
+	*x = TransferFundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *B2CPayment) GetB2CStatus() B2CStatus {
-	if x != nil {
-		return x.B2CStatus
-	}
-	return B2CStatus_B2C_STATUS_UNKNOWN
+func (x *TransferFundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *B2CPayment) GetSource() string {
-	if x != nil {
-		return x.Source
-	}
-	return ""
-}
+func (*TransferFundsResponse) ProtoMessage() {}
 
-func (x *B2CPayment) GetTag() string {
-	if x != nil {
-		return x.Tag
+func (x *TransferFundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *B2CPayment) GetSucceeded() bool {
-	if x != nil {
-		return x.Succeeded
-	}
-	return false
+// Deprecated: Use TransferFundsResponse.ProtoReflect.Descriptor instead.
+func (*TransferFundsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *B2CPayment) GetProcessed() bool {
+func (x *TransferFundsResponse) GetProgress() bool {
 	if x != nil {
-		return x.Processed
+		return x.Progress
 	}
 	return false
 }
 
-func (x *B2CPayment) GetTransactionTimestamp() int64 {
-	if x != nil {
-		return x.TransactionTimestamp
-	}
-	return 0
-}
-
-func (x *B2CPayment) GetCreateDate() string {
+func (x *TransferFundsResponse) GetMessage() string {
 	if x != nil {
-		return x.CreateDate
+		return x.Message
 	}
 	return ""
 }
 
-type GetB2CPaymentRequest struct {
+type PublishMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PaymentId string         `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	IsMpesaId bool           `protobuf:"varint,2,opt,name=is_mpesa_id,json=isMpesaId,proto3" json:"is_mpesa_id,omitempty"`
-	View      B2CPaymentView `protobuf:"varint,3,opt,name=view,proto3,enum=gidyon.mpesa.b2c.B2CPaymentView" json:"view,omitempty"`
+	TransactionId  uint64       `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	InitiatorId    string       `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	MpesaReceiptId string       `protobuf:"bytes,3,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	Msisdn         string       `protobuf:"bytes,4,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	PublishInfo    *PublishInfo `protobuf:"bytes,5,opt,name=publish_info,json=publishInfo,proto3" json:"publish_info,omitempty"`
+	Payment        *B2CPayment  `protobuf:"bytes,6,opt,name=payment,proto3" json:"payment,omitempty"`
+	EventType      B2CEventType `protobuf:"varint,7,opt,name=event_type,json=eventType,proto3,enum=gidyon.mpesa.b2c.B2CEventType" json:"event_type,omitempty"`
 }
 
-func (x *GetB2CPaymentRequest) Reset() {
-	*x = GetB2CPaymentRequest{}
+func (x *PublishMessage) Reset() {
+	*x = PublishMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[5]
+		mi := &file_b2c_v1_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetB2CPaymentRequest) String() string {
+func (x *PublishMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetB2CPaymentRequest) ProtoMessage() {}
+func (*PublishMessage) ProtoMessage() {}
 
-func (x *GetB2CPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[5]
+func (x *PublishMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,195 +1258,89 @@ func (x *GetB2CPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetB2CPaymentRequest.ProtoReflect.Descriptor instead.
-func (*GetB2CPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *GetB2CPaymentRequest) GetPaymentId() string {
-	if x != nil {
-		return x.PaymentId
-	}
-	return ""
-}
-
-func (x *GetB2CPaymentRequest) GetIsMpesaId() bool {
-	if x != nil {
-		return x.IsMpesaId
-	}
-	return false
+// Deprecated: Use PublishMessage.ProtoReflect.Descriptor instead.
+func (*PublishMessage) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetB2CPaymentRequest) GetView() B2CPaymentView {
+func (x *PublishMessage) GetTransactionId() uint64 {
 	if x != nil {
-		return x.View
-	}
-	return B2CPaymentView_B2CPAYMENT_BASIC_VIEW
-}
-
-type ListB2CPaymentFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TxDate                         string            `protobuf:"bytes,1,opt,name=tx_date,json=txDate,proto3" json:"tx_date,omitempty"`
-	Msisdns                        []string          `protobuf:"bytes,2,rep,name=msisdns,proto3" json:"msisdns,omitempty"`
-	MpesaReceipts                  []string          `protobuf:"bytes,3,rep,name=mpesa_receipts,json=mpesaReceipts,proto3" json:"mpesa_receipts,omitempty"`
-	InitiatorIds                   []string          `protobuf:"bytes,4,rep,name=initiator_ids,json=initiatorIds,proto3" json:"initiator_ids,omitempty"`
-	InitiatorCustomerReferences    []string          `protobuf:"bytes,5,rep,name=initiator_customer_references,json=initiatorCustomerReferences,proto3" json:"initiator_customer_references,omitempty"`
-	InitiatorTransactionReferences []string          `protobuf:"bytes,6,rep,name=initiator_transaction_references,json=initiatorTransactionReferences,proto3" json:"initiator_transaction_references,omitempty"`
-	ShortCodes                     []string          `protobuf:"bytes,7,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
-	B2CStatuses                    []B2CStatus       `protobuf:"varint,8,rep,packed,name=b2c_statuses,json=b2cStatuses,proto3,enum=gidyon.mpesa.b2c.B2CStatus" json:"b2c_statuses,omitempty"`
-	ProcessState                   B2CProcessedState `protobuf:"varint,9,opt,name=process_state,json=processState,proto3,enum=gidyon.mpesa.b2c.B2CProcessedState" json:"process_state,omitempty"`
-	StartTimestamp                 int64             `protobuf:"varint,10,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
-	EndTimestamp                   int64             `protobuf:"varint,11,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
-	OrderField                     B2COrderField     `protobuf:"varint,12,opt,name=order_field,json=orderField,proto3,enum=gidyon.mpesa.b2c.B2COrderField" json:"order_field,omitempty"`
-}
-
-func (x *ListB2CPaymentFilter) Reset() {
-	*x = ListB2CPaymentFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListB2CPaymentFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListB2CPaymentFilter) ProtoMessage() {}
-
-func (x *ListB2CPaymentFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TransactionId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListB2CPaymentFilter.ProtoReflect.Descriptor instead.
-func (*ListB2CPaymentFilter) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{6}
+	return 0
 }
 
-func (x *ListB2CPaymentFilter) GetTxDate() string {
+func (x *PublishMessage) GetInitiatorId() string {
 	if x != nil {
-		return x.TxDate
+		return x.InitiatorId
 	}
 	return ""
 }
 
-func (x *ListB2CPaymentFilter) GetMsisdns() []string {
-	if x != nil {
-		return x.Msisdns
-	}
-	return nil
-}
-
-func (x *ListB2CPaymentFilter) GetMpesaReceipts() []string {
-	if x != nil {
-		return x.MpesaReceipts
-	}
-	return nil
-}
-
-func (x *ListB2CPaymentFilter) GetInitiatorIds() []string {
-	if x != nil {
-		return x.InitiatorIds
-	}
-	return nil
-}
-
-func (x *ListB2CPaymentFilter) GetInitiatorCustomerReferences() []string {
+func (x *PublishMessage) GetMpesaReceiptId() string {
 	if x != nil {
-		return x.InitiatorCustomerReferences
+		return x.MpesaReceiptId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListB2CPaymentFilter) GetInitiatorTransactionReferences() []string {
+func (x *PublishMessage) GetMsisdn() string {
 	if x != nil {
-		return x.InitiatorTransactionReferences
+		return x.Msisdn
 	}
-	return nil
+	return ""
 }
 
-func (x *ListB2CPaymentFilter) GetShortCodes() []string {
+func (x *PublishMessage) GetPublishInfo() *PublishInfo {
 	if x != nil {
-		return x.ShortCodes
+		return x.PublishInfo
 	}
 	return nil
 }
 
-func (x *ListB2CPaymentFilter) GetB2CStatuses() []B2CStatus {
+func (x *PublishMessage) GetPayment() *B2CPayment {
 	if x != nil {
-		return x.B2CStatuses
+		return x.Payment
 	}
 	return nil
 }
 
-func (x *ListB2CPaymentFilter) GetProcessState() B2CProcessedState {
-	if x != nil {
-		return x.ProcessState
-	}
-	return B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED
-}
-
-func (x *ListB2CPaymentFilter) GetStartTimestamp() int64 {
-	if x != nil {
-		return x.StartTimestamp
-	}
-	return 0
-}
-
-func (x *ListB2CPaymentFilter) GetEndTimestamp() int64 {
-	if x != nil {
-		return x.EndTimestamp
-	}
-	return 0
-}
-
-func (x *ListB2CPaymentFilter) GetOrderField() B2COrderField {
+func (x *PublishMessage) GetEventType() B2CEventType {
 	if x != nil {
-		return x.OrderField
+		return x.EventType
 	}
-	return B2COrderField_B2C_ORDER_FIELD_UNSPECIFIED
+	return B2CEventType_B2C_EVENT_TYPE_UNSPECIFIED
 }
 
-type ListB2CPaymentsRequest struct {
+type PublishInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageToken string                `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	View      B2CPaymentView        `protobuf:"varint,3,opt,name=view,proto3,enum=gidyon.mpesa.b2c.B2CPaymentView" json:"view,omitempty"`
-	Filter    *ListB2CPaymentFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	OnlyOnSuccess bool              `protobuf:"varint,1,opt,name=only_on_success,json=onlyOnSuccess,proto3" json:"only_on_success,omitempty"`
+	ChannelName   string            `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Payload       map[string]string `protobuf:"bytes,3,rep,name=payload,proto3" json:"payload,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Transport     PublishTransport  `protobuf:"varint,4,opt,name=transport,proto3,enum=gidyon.mpesa.b2c.PublishTransport" json:"transport,omitempty"`
+	EventTypes    []B2CEventType    `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=gidyon.mpesa.b2c.B2CEventType" json:"event_types,omitempty"`
 }
 
-func (x *ListB2CPaymentsRequest) Reset() {
-	*x = ListB2CPaymentsRequest{}
+func (x *PublishInfo) Reset() {
+	*x = PublishInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[7]
+		mi := &file_b2c_v1_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListB2CPaymentsRequest) String() string {
+func (x *PublishInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListB2CPaymentsRequest) ProtoMessage() {}
+func (*PublishInfo) ProtoMessage() {}
 
-func (x *ListB2CPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[7]
+func (x *PublishInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,66 +1351,101 @@ func (x *ListB2CPaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListB2CPaymentsRequest.ProtoReflect.Descriptor instead.
-func (*ListB2CPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use PublishInfo.ProtoReflect.Descriptor instead.
+func (*PublishInfo) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListB2CPaymentsRequest) GetPageToken() string {
+func (x *PublishInfo) GetOnlyOnSuccess() bool {
 	if x != nil {
-		return x.PageToken
+		return x.OnlyOnSuccess
+	}
+	return false
+}
+
+func (x *PublishInfo) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
 	}
 	return ""
 }
 
-func (x *ListB2CPaymentsRequest) GetPageSize() int32 {
+func (x *PublishInfo) GetPayload() map[string]string {
 	if x != nil {
-		return x.PageSize
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *ListB2CPaymentsRequest) GetView() B2CPaymentView {
+func (x *PublishInfo) GetTransport() PublishTransport {
 	if x != nil {
-		return x.View
+		return x.Transport
 	}
-	return B2CPaymentView_B2CPAYMENT_BASIC_VIEW
+	return PublishTransport_PUBLISH_TRANSPORT_UNSPECIFIED
 }
 
-func (x *ListB2CPaymentsRequest) GetFilter() *ListB2CPaymentFilter {
+func (x *PublishInfo) GetEventTypes() []B2CEventType {
 	if x != nil {
-		return x.Filter
+		return x.EventTypes
 	}
 	return nil
 }
 
-type ListB2CPaymentsResponse struct {
+type B2CPayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextPageToken   string        `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	B2CPayments     []*B2CPayment `protobuf:"bytes,2,rep,name=b2c_payments,json=b2cPayments,proto3" json:"b2c_payments,omitempty"`
-	CollectionCount int64         `protobuf:"varint,3,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
+	TransactionId                 uint64    `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	InitiatorId                   string    `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	InitiatorCustomerReference    string    `protobuf:"bytes,3,opt,name=initiator_customer_reference,json=initiatorCustomerReference,proto3" json:"initiator_customer_reference,omitempty"`
+	InitiatorCustomerNames        string    `protobuf:"bytes,4,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
+	OrgShortCode                  string    `protobuf:"bytes,5,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
+	CommandId                     CommandId `protobuf:"varint,6,opt,name=command_id,json=commandId,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_id,omitempty"`
+	Msisdn                        string    `protobuf:"bytes,7,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	Amount                        float32   `protobuf:"fixed32,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	ConversationId                string    `protobuf:"bytes,9,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	OriginalConversationId        string    `protobuf:"bytes,10,opt,name=original_conversation_id,json=originalConversationId,proto3" json:"original_conversation_id,omitempty"`
+	B2CResponseDescription        string    `protobuf:"bytes,11,opt,name=b2c_response_description,json=b2cResponseDescription,proto3" json:"b2c_response_description,omitempty"`
+	B2CResponseCode               string    `protobuf:"bytes,12,opt,name=b2c_response_code,json=b2cResponseCode,proto3" json:"b2c_response_code,omitempty"`
+	B2CResultDescription          string    `protobuf:"bytes,13,opt,name=b2c_result_description,json=b2cResultDescription,proto3" json:"b2c_result_description,omitempty"`
+	B2CResultCode                 string    `protobuf:"bytes,14,opt,name=b2c_result_code,json=b2cResultCode,proto3" json:"b2c_result_code,omitempty"`
+	ReceiverPartyPublicName       string    `protobuf:"bytes,15,opt,name=receiver_party_public_name,json=receiverPartyPublicName,proto3" json:"receiver_party_public_name,omitempty"`
+	MpesaReceiptId                string    `protobuf:"bytes,16,opt,name=mpesa_receipt_id,json=mpesaReceiptId,proto3" json:"mpesa_receipt_id,omitempty"`
+	WorkingAccountFunds           float32   `protobuf:"fixed32,17,opt,name=working_account_funds,json=workingAccountFunds,proto3" json:"working_account_funds,omitempty"`
+	UtilityAccountFunds           float32   `protobuf:"fixed32,18,opt,name=utility_account_funds,json=utilityAccountFunds,proto3" json:"utility_account_funds,omitempty"`
+	MpesaCharges                  float32   `protobuf:"fixed32,19,opt,name=mpesa_charges,json=mpesaCharges,proto3" json:"mpesa_charges,omitempty"`
+	SystemCharges                 float32   `protobuf:"fixed32,20,opt,name=system_charges,json=systemCharges,proto3" json:"system_charges,omitempty"`
+	RecipientRegistered           bool      `protobuf:"varint,21,opt,name=recipient_registered,json=recipientRegistered,proto3" json:"recipient_registered,omitempty"`
+	B2CStatus                     B2CStatus `protobuf:"varint,22,opt,name=b2c_status,json=b2cStatus,proto3,enum=gidyon.mpesa.b2c.B2CStatus" json:"b2c_status,omitempty"`
+	Source                        string    `protobuf:"bytes,23,opt,name=source,proto3" json:"source,omitempty"`
+	Tag                           string    `protobuf:"bytes,24,opt,name=tag,proto3" json:"tag,omitempty"`
+	Succeeded                     bool      `protobuf:"varint,25,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Processed                     bool      `protobuf:"varint,26,opt,name=processed,proto3" json:"processed,omitempty"`
+	TransactionTimestamp          int64     `protobuf:"varint,27,opt,name=transaction_timestamp,json=transactionTimestamp,proto3" json:"transaction_timestamp,omitempty"`
+	CreateDate                    string    `protobuf:"bytes,28,opt,name=create_date,json=createDate,proto3" json:"create_date,omitempty"`
+	InitiatorTransactionReference string    `protobuf:"bytes,29,opt,name=initiator_transaction_reference,json=initiatorTransactionReference,proto3" json:"initiator_transaction_reference,omitempty"`
+	Archived                      bool      `protobuf:"varint,30,opt,name=archived,proto3" json:"archived,omitempty"`
+	ChargesPaidAccountFunds       float32   `protobuf:"fixed32,31,opt,name=charges_paid_account_funds,json=chargesPaidAccountFunds,proto3" json:"charges_paid_account_funds,omitempty"`
 }
 
-func (x *ListB2CPaymentsResponse) Reset() {
-	*x = ListB2CPaymentsResponse{}
+func (x *B2CPayment) Reset() {
+	*x = B2CPayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[8]
+		mi := &file_b2c_v1_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListB2CPaymentsResponse) String() string {
+func (x *B2CPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListB2CPaymentsResponse) ProtoMessage() {}
+func (*B2CPayment) ProtoMessage() {}
 
-func (x *ListB2CPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[8]
+func (x *B2CPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,287 +1456,258 @@ func (x *ListB2CPaymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListB2CPaymentsResponse.ProtoReflect.Descriptor instead.
-func (*ListB2CPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use B2CPayment.ProtoReflect.Descriptor instead.
+func (*B2CPayment) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListB2CPaymentsResponse) GetNextPageToken() string {
+func (x *B2CPayment) GetTransactionId() uint64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.TransactionId
 	}
-	return ""
+	return 0
 }
 
-func (x *ListB2CPaymentsResponse) GetB2CPayments() []*B2CPayment {
+func (x *B2CPayment) GetInitiatorId() string {
 	if x != nil {
-		return x.B2CPayments
+		return x.InitiatorId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListB2CPaymentsResponse) GetCollectionCount() int64 {
+func (x *B2CPayment) GetInitiatorCustomerReference() string {
 	if x != nil {
-		return x.CollectionCount
+		return x.InitiatorCustomerReference
 	}
-	return 0
+	return ""
 }
 
-type ProcessB2CPaymentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *B2CPayment) GetInitiatorCustomerNames() string {
+	if x != nil {
+		return x.InitiatorCustomerNames
+	}
+	return ""
+}
 
-	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
-	Processed bool   `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
-}
-
-func (x *ProcessB2CPaymentRequest) Reset() {
-	*x = ProcessB2CPaymentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *B2CPayment) GetOrgShortCode() string {
+	if x != nil {
+		return x.OrgShortCode
 	}
+	return ""
 }
 
-func (x *ProcessB2CPaymentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *B2CPayment) GetCommandId() CommandId {
+	if x != nil {
+		return x.CommandId
+	}
+	return CommandId_COMMANDID_UNSPECIFIED
 }
 
-func (*ProcessB2CPaymentRequest) ProtoMessage() {}
-
-func (x *ProcessB2CPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *B2CPayment) GetMsisdn() string {
+	if x != nil {
+		return x.Msisdn
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ProcessB2CPaymentRequest.ProtoReflect.Descriptor instead.
-func (*ProcessB2CPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{9}
+func (x *B2CPayment) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *ProcessB2CPaymentRequest) GetPaymentId() string {
+func (x *B2CPayment) GetConversationId() string {
 	if x != nil {
-		return x.PaymentId
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *ProcessB2CPaymentRequest) GetProcessed() bool {
+func (x *B2CPayment) GetOriginalConversationId() string {
 	if x != nil {
-		return x.Processed
+		return x.OriginalConversationId
 	}
-	return false
-}
-
-type PublishB2CPaymentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PublishMessage *PublishMessage   `protobuf:"bytes,1,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
-	ProcessedState B2CProcessedState `protobuf:"varint,2,opt,name=processed_state,json=processedState,proto3,enum=gidyon.mpesa.b2c.B2CProcessedState" json:"processed_state,omitempty"`
+	return ""
 }
 
-func (x *PublishB2CPaymentRequest) Reset() {
-	*x = PublishB2CPaymentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *B2CPayment) GetB2CResponseDescription() string {
+	if x != nil {
+		return x.B2CResponseDescription
 	}
+	return ""
 }
 
-func (x *PublishB2CPaymentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *B2CPayment) GetB2CResponseCode() string {
+	if x != nil {
+		return x.B2CResponseCode
+	}
+	return ""
 }
 
-func (*PublishB2CPaymentRequest) ProtoMessage() {}
-
-func (x *PublishB2CPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *B2CPayment) GetB2CResultDescription() string {
+	if x != nil {
+		return x.B2CResultDescription
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PublishB2CPaymentRequest.ProtoReflect.Descriptor instead.
-func (*PublishB2CPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{10}
+func (x *B2CPayment) GetB2CResultCode() string {
+	if x != nil {
+		return x.B2CResultCode
+	}
+	return ""
 }
 
-func (x *PublishB2CPaymentRequest) GetPublishMessage() *PublishMessage {
+func (x *B2CPayment) GetReceiverPartyPublicName() string {
 	if x != nil {
-		return x.PublishMessage
+		return x.ReceiverPartyPublicName
 	}
-	return nil
+	return ""
 }
 
-func (x *PublishB2CPaymentRequest) GetProcessedState() B2CProcessedState {
+func (x *B2CPayment) GetMpesaReceiptId() string {
 	if x != nil {
-		return x.ProcessedState
+		return x.MpesaReceiptId
 	}
-	return B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED
+	return ""
 }
 
-type DailyStat struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	StatId                 string  `protobuf:"bytes,1,opt,name=stat_id,json=statId,proto3" json:"stat_id,omitempty"`
-	Date                   string  `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	OrgShortCode           string  `protobuf:"bytes,3,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
-	TotalTransactions      int32   `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
-	SuccessfulTransactions int64   `protobuf:"varint,5,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
-	FailedTransactions     int64   `protobuf:"varint,6,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
-	TotalAmountTransacted  float32 `protobuf:"fixed32,7,opt,name=total_amount_transacted,json=totalAmountTransacted,proto3" json:"total_amount_transacted,omitempty"`
-	TotalCharges           float32 `protobuf:"fixed32,8,opt,name=total_charges,json=totalCharges,proto3" json:"total_charges,omitempty"`
-	CreateTimeSeconds      int64   `protobuf:"varint,9,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
-	UpdateTimeSeconds      int64   `protobuf:"varint,10,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+func (x *B2CPayment) GetWorkingAccountFunds() float32 {
+	if x != nil {
+		return x.WorkingAccountFunds
+	}
+	return 0
 }
 
-func (x *DailyStat) Reset() {
-	*x = DailyStat{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *B2CPayment) GetUtilityAccountFunds() float32 {
+	if x != nil {
+		return x.UtilityAccountFunds
 	}
+	return 0
 }
 
-func (x *DailyStat) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *B2CPayment) GetMpesaCharges() float32 {
+	if x != nil {
+		return x.MpesaCharges
+	}
+	return 0
 }
 
-func (*DailyStat) ProtoMessage() {}
-
-func (x *DailyStat) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *B2CPayment) GetSystemCharges() float32 {
+	if x != nil {
+		return x.SystemCharges
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DailyStat.ProtoReflect.Descriptor instead.
-func (*DailyStat) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{11}
+func (x *B2CPayment) GetRecipientRegistered() bool {
+	if x != nil {
+		return x.RecipientRegistered
+	}
+	return false
 }
 
-func (x *DailyStat) GetStatId() string {
+func (x *B2CPayment) GetB2CStatus() B2CStatus {
 	if x != nil {
-		return x.StatId
+		return x.B2CStatus
 	}
-	return ""
+	return B2CStatus_B2C_STATUS_UNKNOWN
 }
 
-func (x *DailyStat) GetDate() string {
+func (x *B2CPayment) GetSource() string {
 	if x != nil {
-		return x.Date
+		return x.Source
 	}
 	return ""
 }
 
-func (x *DailyStat) GetOrgShortCode() string {
+func (x *B2CPayment) GetTag() string {
 	if x != nil {
-		return x.OrgShortCode
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *DailyStat) GetTotalTransactions() int32 {
+func (x *B2CPayment) GetSucceeded() bool {
 	if x != nil {
-		return x.TotalTransactions
+		return x.Succeeded
 	}
-	return 0
+	return false
 }
 
-func (x *DailyStat) GetSuccessfulTransactions() int64 {
+func (x *B2CPayment) GetProcessed() bool {
 	if x != nil {
-		return x.SuccessfulTransactions
+		return x.Processed
 	}
-	return 0
+	return false
 }
 
-func (x *DailyStat) GetFailedTransactions() int64 {
+func (x *B2CPayment) GetTransactionTimestamp() int64 {
 	if x != nil {
-		return x.FailedTransactions
+		return x.TransactionTimestamp
 	}
 	return 0
 }
 
-func (x *DailyStat) GetTotalAmountTransacted() float32 {
+func (x *B2CPayment) GetCreateDate() string {
 	if x != nil {
-		return x.TotalAmountTransacted
+		return x.CreateDate
 	}
-	return 0
+	return ""
 }
 
-func (x *DailyStat) GetTotalCharges() float32 {
+func (x *B2CPayment) GetInitiatorTransactionReference() string {
 	if x != nil {
-		return x.TotalCharges
+		return x.InitiatorTransactionReference
 	}
-	return 0
+	return ""
 }
 
-func (x *DailyStat) GetCreateTimeSeconds() int64 {
+func (x *B2CPayment) GetArchived() bool {
 	if x != nil {
-		return x.CreateTimeSeconds
+		return x.Archived
 	}
-	return 0
+	return false
 }
 
-func (x *DailyStat) GetUpdateTimeSeconds() int64 {
+func (x *B2CPayment) GetChargesPaidAccountFunds() float32 {
 	if x != nil {
-		return x.UpdateTimeSeconds
+		return x.ChargesPaidAccountFunds
 	}
 	return 0
 }
 
-type StatsResponse struct {
+type GetB2CPaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stats         []*DailyStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	PaymentId string                `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	IsMpesaId bool                  `protobuf:"varint,2,opt,name=is_mpesa_id,json=isMpesaId,proto3" json:"is_mpesa_id,omitempty"`
+	View      B2CPaymentView        `protobuf:"varint,3,opt,name=view,proto3,enum=gidyon.mpesa.b2c.B2CPaymentView" json:"view,omitempty"`
+	ReadMask  *field_mask.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// Also looks for the payment among archived payments
+	IncludeArchived bool `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
 }
 
-func (x *StatsResponse) Reset() {
-	*x = StatsResponse{}
+func (x *GetB2CPaymentRequest) Reset() {
+	*x = GetB2CPaymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[12]
+		mi := &file_b2c_v1_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatsResponse) String() string {
+func (x *GetB2CPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsResponse) ProtoMessage() {}
+func (*GetB2CPaymentRequest) ProtoMessage() {}
 
-func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[12]
+func (x *GetB2CPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1573,123 +1718,90 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
-func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetB2CPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetB2CPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StatsResponse) GetStats() []*DailyStat {
+func (x *GetB2CPaymentRequest) GetPaymentId() string {
 	if x != nil {
-		return x.Stats
+		return x.PaymentId
 	}
-	return nil
+	return ""
 }
 
-func (x *StatsResponse) GetNextPageToken() string {
+func (x *GetB2CPaymentRequest) GetIsMpesaId() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.IsMpesaId
 	}
-	return ""
+	return false
 }
 
-type ListStatsFilter struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OrganizationShortCodes []string `protobuf:"bytes,1,rep,name=organization_short_codes,json=organizationShortCodes,proto3" json:"organization_short_codes,omitempty"`
-	StartTimeSeconds       int64    `protobuf:"varint,2,opt,name=start_time_seconds,json=startTimeSeconds,proto3" json:"start_time_seconds,omitempty"`
-	EndTimeSeconds         int64    `protobuf:"varint,3,opt,name=end_time_seconds,json=endTimeSeconds,proto3" json:"end_time_seconds,omitempty"`
-	TxDates                []string `protobuf:"bytes,4,rep,name=tx_dates,json=txDates,proto3" json:"tx_dates,omitempty"`
-}
-
-func (x *ListStatsFilter) Reset() {
-	*x = ListStatsFilter{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ListStatsFilter) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListStatsFilter) ProtoMessage() {}
-
-func (x *ListStatsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ListStatsFilter.ProtoReflect.Descriptor instead.
-func (*ListStatsFilter) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ListStatsFilter) GetOrganizationShortCodes() []string {
-	if x != nil {
-		return x.OrganizationShortCodes
-	}
-	return nil
-}
-
-func (x *ListStatsFilter) GetStartTimeSeconds() int64 {
+func (x *GetB2CPaymentRequest) GetView() B2CPaymentView {
 	if x != nil {
-		return x.StartTimeSeconds
+		return x.View
 	}
-	return 0
+	return B2CPaymentView_B2CPAYMENT_BASIC_VIEW
 }
 
-func (x *ListStatsFilter) GetEndTimeSeconds() int64 {
+func (x *GetB2CPaymentRequest) GetReadMask() *field_mask.FieldMask {
 	if x != nil {
-		return x.EndTimeSeconds
+		return x.ReadMask
 	}
-	return 0
+	return nil
 }
 
-func (x *ListStatsFilter) GetTxDates() []string {
+func (x *GetB2CPaymentRequest) GetIncludeArchived() bool {
 	if x != nil {
-		return x.TxDates
+		return x.IncludeArchived
 	}
-	return nil
+	return false
 }
 
-type ListDailyStatsRequest struct {
+type ListB2CPaymentFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageToken string           `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	PageSize  int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Filter    *ListStatsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	TxDate                         string            `protobuf:"bytes,1,opt,name=tx_date,json=txDate,proto3" json:"tx_date,omitempty"`
+	Msisdns                        []string          `protobuf:"bytes,2,rep,name=msisdns,proto3" json:"msisdns,omitempty"`
+	MpesaReceipts                  []string          `protobuf:"bytes,3,rep,name=mpesa_receipts,json=mpesaReceipts,proto3" json:"mpesa_receipts,omitempty"`
+	InitiatorIds                   []string          `protobuf:"bytes,4,rep,name=initiator_ids,json=initiatorIds,proto3" json:"initiator_ids,omitempty"`
+	InitiatorCustomerReferences    []string          `protobuf:"bytes,5,rep,name=initiator_customer_references,json=initiatorCustomerReferences,proto3" json:"initiator_customer_references,omitempty"`
+	InitiatorTransactionReferences []string          `protobuf:"bytes,6,rep,name=initiator_transaction_references,json=initiatorTransactionReferences,proto3" json:"initiator_transaction_references,omitempty"`
+	ShortCodes                     []string          `protobuf:"bytes,7,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
+	B2CStatuses                    []B2CStatus       `protobuf:"varint,8,rep,packed,name=b2c_statuses,json=b2cStatuses,proto3,enum=gidyon.mpesa.b2c.B2CStatus" json:"b2c_statuses,omitempty"`
+	ProcessState                   B2CProcessedState `protobuf:"varint,9,opt,name=process_state,json=processState,proto3,enum=gidyon.mpesa.b2c.B2CProcessedState" json:"process_state,omitempty"`
+	StartTimestamp                 int64             `protobuf:"varint,10,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	EndTimestamp                   int64             `protobuf:"varint,11,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	OrderField                     B2COrderField     `protobuf:"varint,12,opt,name=order_field,json=orderField,proto3,enum=gidyon.mpesa.b2c.B2COrderField" json:"order_field,omitempty"`
+	OrderDirection                 B2COrderDirection `protobuf:"varint,13,opt,name=order_direction,json=orderDirection,proto3,enum=gidyon.mpesa.b2c.B2COrderDirection" json:"order_direction,omitempty"`
+	AmountMin                      float32           `protobuf:"fixed32,14,opt,name=amount_min,json=amountMin,proto3" json:"amount_min,omitempty"`
+	AmountMax                      float32           `protobuf:"fixed32,15,opt,name=amount_max,json=amountMax,proto3" json:"amount_max,omitempty"`
+	CommandIds                     []CommandId       `protobuf:"varint,16,rep,packed,name=command_ids,json=commandIds,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_ids,omitempty"`
+	SucceededState                 B2CSucceededState `protobuf:"varint,17,opt,name=succeeded_state,json=succeededState,proto3,enum=gidyon.mpesa.b2c.B2CSucceededState" json:"succeeded_state,omitempty"`
+	RecipientState                 B2CRecipientState `protobuf:"varint,18,opt,name=recipient_state,json=recipientState,proto3,enum=gidyon.mpesa.b2c.B2CRecipientState" json:"recipient_state,omitempty"`
+	InitiatorCustomerNames         string            `protobuf:"bytes,19,opt,name=initiator_customer_names,json=initiatorCustomerNames,proto3" json:"initiator_customer_names,omitempty"`
+	ReceiverPartyPublicName        string            `protobuf:"bytes,20,opt,name=receiver_party_public_name,json=receiverPartyPublicName,proto3" json:"receiver_party_public_name,omitempty"`
 }
 
-func (x *ListDailyStatsRequest) Reset() {
-	*x = ListDailyStatsRequest{}
+func (x *ListB2CPaymentFilter) Reset() {
+	*x = ListB2CPaymentFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[14]
+		mi := &file_b2c_v1_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListDailyStatsRequest) String() string {
+func (x *ListB2CPaymentFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDailyStatsRequest) ProtoMessage() {}
+func (*ListB2CPaymentFilter) ProtoMessage() {}
 
-func (x *ListDailyStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[14]
+func (x *ListB2CPaymentFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,179 +1812,180 @@ func (x *ListDailyStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDailyStatsRequest.ProtoReflect.Descriptor instead.
-func (*ListDailyStatsRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListB2CPaymentFilter.ProtoReflect.Descriptor instead.
+func (*ListB2CPaymentFilter) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListDailyStatsRequest) GetPageToken() string {
+func (x *ListB2CPaymentFilter) GetTxDate() string {
 	if x != nil {
-		return x.PageToken
+		return x.TxDate
 	}
 	return ""
 }
 
-func (x *ListDailyStatsRequest) GetPageSize() int32 {
+func (x *ListB2CPaymentFilter) GetMsisdns() []string {
 	if x != nil {
-		return x.PageSize
+		return x.Msisdns
 	}
-	return 0
+	return nil
 }
 
-func (x *ListDailyStatsRequest) GetFilter() *ListStatsFilter {
+func (x *ListB2CPaymentFilter) GetMpesaReceipts() []string {
 	if x != nil {
-		return x.Filter
+		return x.MpesaReceipts
 	}
 	return nil
 }
 
-type QueryTransactionStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	IdentifierType     QueryTransactionStatusRequest_IdentifierType `protobuf:"varint,1,opt,name=identifier_type,json=identifierType,proto3,enum=gidyon.mpesa.b2c.QueryTransactionStatusRequest_IdentifierType" json:"identifier_type,omitempty"`
-	PartyA             int64                                        `protobuf:"varint,2,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
-	Remarks            string                                       `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
-	Initiator          string                                       `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
-	SecurityCredential string                                       `protobuf:"bytes,5,opt,name=security_credential,json=securityCredential,proto3" json:"security_credential,omitempty"`
-	QueueTimeoutUrl    string                                       `protobuf:"bytes,6,opt,name=queue_timeout_url,json=queueTimeoutUrl,proto3" json:"queue_timeout_url,omitempty"`
-	ResultUrl          string                                       `protobuf:"bytes,7,opt,name=result_url,json=resultUrl,proto3" json:"result_url,omitempty"`
-	TransactionId      string                                       `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Occassion          string                                       `protobuf:"bytes,9,opt,name=occassion,proto3" json:"occassion,omitempty"`
-	Synchronous        bool                                         `protobuf:"varint,10,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+func (x *ListB2CPaymentFilter) GetInitiatorIds() []string {
+	if x != nil {
+		return x.InitiatorIds
+	}
+	return nil
 }
 
-func (x *QueryTransactionStatusRequest) Reset() {
-	*x = QueryTransactionStatusRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ListB2CPaymentFilter) GetInitiatorCustomerReferences() []string {
+	if x != nil {
+		return x.InitiatorCustomerReferences
 	}
+	return nil
 }
 
-func (x *QueryTransactionStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListB2CPaymentFilter) GetInitiatorTransactionReferences() []string {
+	if x != nil {
+		return x.InitiatorTransactionReferences
+	}
+	return nil
 }
 
-func (*QueryTransactionStatusRequest) ProtoMessage() {}
+func (x *ListB2CPaymentFilter) GetShortCodes() []string {
+	if x != nil {
+		return x.ShortCodes
+	}
+	return nil
+}
 
-func (x *QueryTransactionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ListB2CPaymentFilter) GetB2CStatuses() []B2CStatus {
+	if x != nil {
+		return x.B2CStatuses
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use QueryTransactionStatusRequest.ProtoReflect.Descriptor instead.
-func (*QueryTransactionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{15}
+func (x *ListB2CPaymentFilter) GetProcessState() B2CProcessedState {
+	if x != nil {
+		return x.ProcessState
+	}
+	return B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED
 }
 
-func (x *QueryTransactionStatusRequest) GetIdentifierType() QueryTransactionStatusRequest_IdentifierType {
+func (x *ListB2CPaymentFilter) GetStartTimestamp() int64 {
 	if x != nil {
-		return x.IdentifierType
+		return x.StartTimestamp
 	}
-	return QueryTransactionStatusRequest_QUERY_TRANSACTION_UNSPECIFIED
+	return 0
 }
 
-func (x *QueryTransactionStatusRequest) GetPartyA() int64 {
+func (x *ListB2CPaymentFilter) GetEndTimestamp() int64 {
 	if x != nil {
-		return x.PartyA
+		return x.EndTimestamp
 	}
 	return 0
 }
 
-func (x *QueryTransactionStatusRequest) GetRemarks() string {
+func (x *ListB2CPaymentFilter) GetOrderField() B2COrderField {
 	if x != nil {
-		return x.Remarks
+		return x.OrderField
 	}
-	return ""
+	return B2COrderField_B2C_ORDER_FIELD_UNSPECIFIED
 }
 
-func (x *QueryTransactionStatusRequest) GetInitiator() string {
+func (x *ListB2CPaymentFilter) GetOrderDirection() B2COrderDirection {
 	if x != nil {
-		return x.Initiator
+		return x.OrderDirection
 	}
-	return ""
+	return B2COrderDirection_B2C_ORDER_DIRECTION_UNSPECIFIED
 }
 
-func (x *QueryTransactionStatusRequest) GetSecurityCredential() string {
+func (x *ListB2CPaymentFilter) GetAmountMin() float32 {
 	if x != nil {
-		return x.SecurityCredential
+		return x.AmountMin
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryTransactionStatusRequest) GetQueueTimeoutUrl() string {
+func (x *ListB2CPaymentFilter) GetAmountMax() float32 {
 	if x != nil {
-		return x.QueueTimeoutUrl
+		return x.AmountMax
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryTransactionStatusRequest) GetResultUrl() string {
+func (x *ListB2CPaymentFilter) GetCommandIds() []CommandId {
 	if x != nil {
-		return x.ResultUrl
+		return x.CommandIds
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryTransactionStatusRequest) GetTransactionId() string {
+func (x *ListB2CPaymentFilter) GetSucceededState() B2CSucceededState {
 	if x != nil {
-		return x.TransactionId
+		return x.SucceededState
 	}
-	return ""
+	return B2CSucceededState_B2C_SUCCEEDED_STATE_UNSPECIFIED
 }
 
-func (x *QueryTransactionStatusRequest) GetOccassion() string {
+func (x *ListB2CPaymentFilter) GetRecipientState() B2CRecipientState {
 	if x != nil {
-		return x.Occassion
+		return x.RecipientState
+	}
+	return B2CRecipientState_B2C_RECIPIENT_STATE_UNSPECIFIED
+}
+
+func (x *ListB2CPaymentFilter) GetInitiatorCustomerNames() string {
+	if x != nil {
+		return x.InitiatorCustomerNames
 	}
 	return ""
 }
 
-func (x *QueryTransactionStatusRequest) GetSynchronous() bool {
+func (x *ListB2CPaymentFilter) GetReceiverPartyPublicName() string {
 	if x != nil {
-		return x.Synchronous
+		return x.ReceiverPartyPublicName
 	}
-	return false
+	return ""
 }
 
-type QueryResponse struct {
+type ListB2CPaymentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OriginatorConversionId string `protobuf:"bytes,1,opt,name=originator_conversion_id,json=originatorConversionId,proto3" json:"originator_conversion_id,omitempty"`
-	ConversionId           string `protobuf:"bytes,2,opt,name=conversion_id,json=conversionId,proto3" json:"conversion_id,omitempty"`
-	ResponseDescription    string `protobuf:"bytes,3,opt,name=response_description,json=responseDescription,proto3" json:"response_description,omitempty"`
-	Success                bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	PageToken string                `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	View      B2CPaymentView        `protobuf:"varint,3,opt,name=view,proto3,enum=gidyon.mpesa.b2c.B2CPaymentView" json:"view,omitempty"`
+	Filter    *ListB2CPaymentFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	ReadMask  *field_mask.FieldMask `protobuf:"bytes,5,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
 }
 
-func (x *QueryResponse) Reset() {
-	*x = QueryResponse{}
+func (x *ListB2CPaymentsRequest) Reset() {
+	*x = ListB2CPaymentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[16]
+		mi := &file_b2c_v1_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryResponse) String() string {
+func (x *ListB2CPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryResponse) ProtoMessage() {}
+func (*ListB2CPaymentsRequest) ProtoMessage() {}
 
-func (x *QueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[16]
+func (x *ListB2CPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1883,69 +1996,73 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
-func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListB2CPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListB2CPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *QueryResponse) GetOriginatorConversionId() string {
+func (x *ListB2CPaymentsRequest) GetPageToken() string {
 	if x != nil {
-		return x.OriginatorConversionId
+		return x.PageToken
 	}
 	return ""
 }
 
-func (x *QueryResponse) GetConversionId() string {
+func (x *ListB2CPaymentsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ConversionId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryResponse) GetResponseDescription() string {
+func (x *ListB2CPaymentsRequest) GetView() B2CPaymentView {
 	if x != nil {
-		return x.ResponseDescription
+		return x.View
 	}
-	return ""
+	return B2CPaymentView_B2CPAYMENT_BASIC_VIEW
 }
 
-func (x *QueryResponse) GetSuccess() bool {
+func (x *ListB2CPaymentsRequest) GetFilter() *ListB2CPaymentFilter {
 	if x != nil {
-		return x.Success
+		return x.Filter
 	}
-	return false
+	return nil
 }
 
-type QueryAccountBalanceRequest struct {
+func (x *ListB2CPaymentsRequest) GetReadMask() *field_mask.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListB2CPaymentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IdentifierType QueryAccountBalanceRequest_IdentifierType `protobuf:"varint,1,opt,name=identifier_type,json=identifierType,proto3,enum=gidyon.mpesa.b2c.QueryAccountBalanceRequest_IdentifierType" json:"identifier_type,omitempty"`
-	PartyA         int64                                     `protobuf:"varint,2,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
-	Remarks        string                                    `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
-	InitiatorId    string                                    `protobuf:"bytes,4,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	RequestId      string                                    `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Synchronous    bool                                      `protobuf:"varint,6,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+	NextPageToken   string        `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	B2CPayments     []*B2CPayment `protobuf:"bytes,2,rep,name=b2c_payments,json=b2cPayments,proto3" json:"b2c_payments,omitempty"`
+	CollectionCount int64         `protobuf:"varint,3,opt,name=collection_count,json=collectionCount,proto3" json:"collection_count,omitempty"`
 }
 
-func (x *QueryAccountBalanceRequest) Reset() {
-	*x = QueryAccountBalanceRequest{}
+func (x *ListB2CPaymentsResponse) Reset() {
+	*x = ListB2CPaymentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[17]
+		mi := &file_b2c_v1_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAccountBalanceRequest) String() string {
+func (x *ListB2CPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAccountBalanceRequest) ProtoMessage() {}
+func (*ListB2CPaymentsResponse) ProtoMessage() {}
 
-func (x *QueryAccountBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[17]
+func (x *ListB2CPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,84 +2073,140 @@ func (x *QueryAccountBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAccountBalanceRequest.ProtoReflect.Descriptor instead.
-func (*QueryAccountBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListB2CPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListB2CPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *QueryAccountBalanceRequest) GetIdentifierType() QueryAccountBalanceRequest_IdentifierType {
+func (x *ListB2CPaymentsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.IdentifierType
+		return x.NextPageToken
 	}
-	return QueryAccountBalanceRequest_QUERY_ACCOUNT_UNSPECIFIED
+	return ""
 }
 
-func (x *QueryAccountBalanceRequest) GetPartyA() int64 {
+func (x *ListB2CPaymentsResponse) GetB2CPayments() []*B2CPayment {
 	if x != nil {
-		return x.PartyA
+		return x.B2CPayments
+	}
+	return nil
+}
+
+func (x *ListB2CPaymentsResponse) GetCollectionCount() int64 {
+	if x != nil {
+		return x.CollectionCount
 	}
 	return 0
 }
 
-func (x *QueryAccountBalanceRequest) GetRemarks() string {
+type AggregatePaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter     *ListB2CPaymentFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	GroupBy    []AggregateGroupBy    `protobuf:"varint,2,rep,packed,name=group_by,json=groupBy,proto3,enum=gidyon.mpesa.b2c.AggregateGroupBy" json:"group_by,omitempty"`
+	TimeBucket TimeBucket            `protobuf:"varint,3,opt,name=time_bucket,json=timeBucket,proto3,enum=gidyon.mpesa.b2c.TimeBucket" json:"time_bucket,omitempty"`
+	Limit      int32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *AggregatePaymentsRequest) Reset() {
+	*x = AggregatePaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregatePaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatePaymentsRequest) ProtoMessage() {}
+
+func (x *AggregatePaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatePaymentsRequest.ProtoReflect.Descriptor instead.
+func (*AggregatePaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AggregatePaymentsRequest) GetFilter() *ListB2CPaymentFilter {
 	if x != nil {
-		return x.Remarks
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryAccountBalanceRequest) GetInitiatorId() string {
+func (x *AggregatePaymentsRequest) GetGroupBy() []AggregateGroupBy {
 	if x != nil {
-		return x.InitiatorId
+		return x.GroupBy
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryAccountBalanceRequest) GetRequestId() string {
+func (x *AggregatePaymentsRequest) GetTimeBucket() TimeBucket {
 	if x != nil {
-		return x.RequestId
+		return x.TimeBucket
 	}
-	return ""
+	return TimeBucket_TIME_BUCKET_UNSPECIFIED
 }
 
-func (x *QueryAccountBalanceRequest) GetSynchronous() bool {
+func (x *AggregatePaymentsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Synchronous
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type QueryAccountBalanceResponse struct {
+type PaymentAggregate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Party               int64   `protobuf:"varint,1,opt,name=party,proto3" json:"party,omitempty"`
-	WorkingAccountFunds float32 `protobuf:"fixed32,2,opt,name=working_account_funds,json=workingAccountFunds,proto3" json:"working_account_funds,omitempty"`
-	UtilityAccountFunds float32 `protobuf:"fixed32,3,opt,name=utility_account_funds,json=utilityAccountFunds,proto3" json:"utility_account_funds,omitempty"`
-	ChargesPaidFunds    float32 `protobuf:"fixed32,4,opt,name=charges_paid_funds,json=chargesPaidFunds,proto3" json:"charges_paid_funds,omitempty"`
-	RequestId           string  `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	InitiatorId         string  `protobuf:"bytes,6,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	Completed           bool    `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
-}
-
-func (x *QueryAccountBalanceResponse) Reset() {
-	*x = QueryAccountBalanceResponse{}
+	OrgShortCode      string    `protobuf:"bytes,1,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
+	InitiatorId       string    `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	CommandId         CommandId `protobuf:"varint,3,opt,name=command_id,json=commandId,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_id,omitempty"`
+	B2CStatus         B2CStatus `protobuf:"varint,4,opt,name=b2c_status,json=b2cStatus,proto3,enum=gidyon.mpesa.b2c.B2CStatus" json:"b2c_status,omitempty"`
+	Msisdn            string    `protobuf:"bytes,5,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	BucketStart       string    `protobuf:"bytes,6,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	Count             int64     `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	SuccessfulCount   int64     `protobuf:"varint,8,opt,name=successful_count,json=successfulCount,proto3" json:"successful_count,omitempty"`
+	TotalAmount       float64   `protobuf:"fixed64,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	AverageAmount     float64   `protobuf:"fixed64,10,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"`
+	TotalCharges      float64   `protobuf:"fixed64,11,opt,name=total_charges,json=totalCharges,proto3" json:"total_charges,omitempty"`
+	TotalMpesaCharges float64   `protobuf:"fixed64,12,opt,name=total_mpesa_charges,json=totalMpesaCharges,proto3" json:"total_mpesa_charges,omitempty"`
+	SuccessRate       float64   `protobuf:"fixed64,13,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+}
+
+func (x *PaymentAggregate) Reset() {
+	*x = PaymentAggregate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[18]
+		mi := &file_b2c_v1_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAccountBalanceResponse) String() string {
+func (x *PaymentAggregate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAccountBalanceResponse) ProtoMessage() {}
+func (*PaymentAggregate) ProtoMessage() {}
 
-func (x *QueryAccountBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[18]
+func (x *PaymentAggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2044,898 +2217,6303 @@ func (x *QueryAccountBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryAccountBalanceResponse.ProtoReflect.Descriptor instead.
-func (*QueryAccountBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PaymentAggregate.ProtoReflect.Descriptor instead.
+func (*PaymentAggregate) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryAccountBalanceResponse) GetParty() int64 {
+func (x *PaymentAggregate) GetOrgShortCode() string {
 	if x != nil {
-		return x.Party
+		return x.OrgShortCode
+	}
+	return ""
+}
+
+func (x *PaymentAggregate) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *PaymentAggregate) GetCommandId() CommandId {
+	if x != nil {
+		return x.CommandId
+	}
+	return CommandId_COMMANDID_UNSPECIFIED
+}
+
+func (x *PaymentAggregate) GetB2CStatus() B2CStatus {
+	if x != nil {
+		return x.B2CStatus
+	}
+	return B2CStatus_B2C_STATUS_UNKNOWN
+}
+
+func (x *PaymentAggregate) GetMsisdn() string {
+	if x != nil {
+		return x.Msisdn
+	}
+	return ""
+}
+
+func (x *PaymentAggregate) GetBucketStart() string {
+	if x != nil {
+		return x.BucketStart
+	}
+	return ""
+}
+
+func (x *PaymentAggregate) GetCount() int64 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-func (x *QueryAccountBalanceResponse) GetWorkingAccountFunds() float32 {
+func (x *PaymentAggregate) GetSuccessfulCount() int64 {
 	if x != nil {
-		return x.WorkingAccountFunds
+		return x.SuccessfulCount
 	}
 	return 0
 }
 
-func (x *QueryAccountBalanceResponse) GetUtilityAccountFunds() float32 {
+func (x *PaymentAggregate) GetTotalAmount() float64 {
 	if x != nil {
-		return x.UtilityAccountFunds
+		return x.TotalAmount
 	}
 	return 0
 }
 
-func (x *QueryAccountBalanceResponse) GetChargesPaidFunds() float32 {
+func (x *PaymentAggregate) GetAverageAmount() float64 {
 	if x != nil {
-		return x.ChargesPaidFunds
+		return x.AverageAmount
 	}
 	return 0
 }
 
-func (x *QueryAccountBalanceResponse) GetRequestId() string {
+func (x *PaymentAggregate) GetTotalCharges() float64 {
 	if x != nil {
-		return x.RequestId
+		return x.TotalCharges
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryAccountBalanceResponse) GetInitiatorId() string {
+func (x *PaymentAggregate) GetTotalMpesaCharges() float64 {
 	if x != nil {
-		return x.InitiatorId
+		return x.TotalMpesaCharges
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryAccountBalanceResponse) GetCompleted() bool {
+func (x *PaymentAggregate) GetSuccessRate() float64 {
 	if x != nil {
-		return x.Completed
+		return x.SuccessRate
+	}
+	return 0
+}
+
+type AggregatePaymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aggregates []*PaymentAggregate `protobuf:"bytes,1,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
+	Truncated  bool                `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *AggregatePaymentsResponse) Reset() {
+	*x = AggregatePaymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregatePaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregatePaymentsResponse) ProtoMessage() {}
+
+func (x *AggregatePaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregatePaymentsResponse.ProtoReflect.Descriptor instead.
+func (*AggregatePaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AggregatePaymentsResponse) GetAggregates() []*PaymentAggregate {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+func (x *AggregatePaymentsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
 	}
 	return false
 }
 
-type ReverseTransactionRequest struct {
+type ExportB2CPaymentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReceiverType  int64  `protobuf:"varint,1,opt,name=receiver_type,json=receiverType,proto3" json:"receiver_type,omitempty"`
-	ShortCode     int32  `protobuf:"varint,2,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
-	Remarks       string `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
-	TransactionId string `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Occassion     string `protobuf:"bytes,5,opt,name=occassion,proto3" json:"occassion,omitempty"`
-	RequestId     string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	InitiatorId   string `protobuf:"bytes,7,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
-	Synchronous   bool   `protobuf:"varint,8,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+	Filter  *ListB2CPaymentFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Format  ExportFormat          `protobuf:"varint,2,opt,name=format,proto3,enum=gidyon.mpesa.b2c.ExportFormat" json:"format,omitempty"`
+	Columns []string              `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
 }
 
-func (x *ReverseTransactionRequest) Reset() {
-	*x = ReverseTransactionRequest{}
+func (x *ExportB2CPaymentsRequest) Reset() {
+	*x = ExportB2CPaymentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_b2c_v1_proto_msgTypes[19]
+		mi := &file_b2c_v1_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReverseTransactionRequest) String() string {
+func (x *ExportB2CPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReverseTransactionRequest) ProtoMessage() {}
+func (*ExportB2CPaymentsRequest) ProtoMessage() {}
 
-func (x *ReverseTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_b2c_v1_proto_msgTypes[19]
+func (x *ExportB2CPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReverseTransactionRequest.ProtoReflect.Descriptor instead.
-func (*ReverseTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_b2c_v1_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ReverseTransactionRequest) GetReceiverType() int64 {
-	if x != nil {
-		return x.ReceiverType
-	}
-	return 0
-}
-
-func (x *ReverseTransactionRequest) GetShortCode() int32 {
-	if x != nil {
-		return x.ShortCode
-	}
-	return 0
-}
-
-func (x *ReverseTransactionRequest) GetRemarks() string {
-	if x != nil {
-		return x.Remarks
-	}
-	return ""
-}
-
-func (x *ReverseTransactionRequest) GetTransactionId() string {
-	if x != nil {
-		return x.TransactionId
-	}
-	return ""
-}
-
-func (x *ReverseTransactionRequest) GetOccassion() string {
-	if x != nil {
-		return x.Occassion
-	}
-	return ""
-}
-
-func (x *ReverseTransactionRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *ReverseTransactionRequest) GetInitiatorId() string {
-	if x != nil {
-		return x.InitiatorId
-	}
-	return ""
-}
-
-func (x *ReverseTransactionRequest) GetSynchronous() bool {
-	if x != nil {
-		return x.Synchronous
-	}
-	return false
-}
-
-var File_b2c_v1_proto protoreflect.FileDescriptor
-
-var file_b2c_v1_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x62, 0x32, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65,
-	0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x04,
-	0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40,
-	0x0a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x73,
-	0x69, 0x73, 0x64, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07,
-	0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x63, 0x63, 0x61, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x09, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
-	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
-	0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x12, 0x46, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a,
-	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x37, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
-	0x6f, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x66, 0x75, 0x6e, 0x64, 0x73,
-	0x20, 0x62, 0x32, 0x63, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
-	0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x91,
-	0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x42,
-	0x92, 0x41, 0x3f, 0x0a, 0x3d, 0x2a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46,
-	0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x24, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0xd1, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
-	0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69,
-	0x73, 0x64, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64,
-	0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x66,
-	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
-	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x39, 0x92, 0x41, 0x36,
-	0x0a, 0x34, 0x2a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x32, 0x22, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x20, 0x73, 0x74,
-	0x6b, 0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e,
-	0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f,
-	0x6e, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x44, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66,
-	0x6f, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0xff, 0x09, 0x0a, 0x0a, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x1c,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x38,
-	0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x5f,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x52,
-	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73,
-	0x69, 0x73, 0x64, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73,
-	0x64, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a,
-	0x18, 0x62, 0x32, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x16, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x32, 0x63, 0x5f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x32, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x32, 0x63,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x61,
-	0x72, 0x74, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50,
-	0x61, 0x72, 0x74, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28,
-	0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64,
-	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15,
-	0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x75, 0x74, 0x69,
-	0x6c, 0x69, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
-	0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x43, 0x68,
-	0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f,
-	0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x73,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x14,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12,
-	0x3a, 0x0a, 0x0a, 0x62, 0x32, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x09, 0x62, 0x32, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64,
-	0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65,
-	0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x64, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x3a, 0x2c, 0x92, 0x41, 0x29, 0x0a, 0x27, 0x2a, 0x0a,
-	0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x19, 0x4d, 0x70, 0x65, 0x73,
-	0x61, 0x20, 0x42, 0x32, 0x43, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d, 0x70, 0x65, 0x73, 0x61,
-	0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
-	0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69,
-	0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x3a, 0x4b, 0x92, 0x41, 0x48, 0x0a, 0x46, 0x2a,
-	0x14, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x21, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
-	0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x62, 0x32, 0x63,
-	0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0xd2, 0x01, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x9d, 0x05, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17,
-	0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64,
-	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x70, 0x65, 0x73, 0x61,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x42, 0x0a,
-	0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x48, 0x0a, 0x20, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1e, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c,
-	0x62, 0x32, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x0b, 0x62, 0x32, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x0b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x14, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x32, 0x20, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x04,
-	0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42,
-	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x32, 0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65,
-	0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x32, 0x63, 0x5f, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
-	0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x62, 0x32, 0x63,
-	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0x2f, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x65, 0x64, 0x3a, 0x4e, 0x92, 0x41, 0x4b, 0x0a, 0x49, 0x2a, 0x18, 0x50, 0x72, 0x6f, 0x63,
-	0x65, 0x73, 0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x32, 0x2d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f,
-	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x20, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42,
-	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x4e, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x4c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x41,
-	0x92, 0x41, 0x3e, 0x0a, 0x3c, 0x2a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
-	0x20, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x20, 0x61, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x22, 0xed, 0x03, 0x0a, 0x09, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
-	0x6f, 0x72, 0x67, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x37, 0x0a, 0x17, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61,
-	0x72, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a,
-	0x09, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x32, 0x25, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x64, 0x61, 0x79,
-	0x20, 0x62, 0x32, 0x63, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0xa9, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3d,
-	0x92, 0x41, 0x3a, 0x0a, 0x38, 0x2a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x27, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xfe, 0x01,
-	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x3e,
-	0x92, 0x41, 0x3b, 0x0a, 0x39, 0x2a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x32, 0x26, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x63,
-	0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xc7,
-	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
-	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
-	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x3a,
-	0x37, 0x92, 0x41, 0x34, 0x0a, 0x32, 0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x73, 0x74,
-	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x8a, 0x05, 0x0a, 0x1d, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0f, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x3e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54,
-	0x79, 0x70, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74,
-	0x79, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x72, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x71, 0x75, 0x65,
-	0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
-	0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e,
-	0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
-	0x1d, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x53, 0x49, 0x53, 0x44, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a,
-	0x17, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x3a, 0x49, 0x92, 0x41, 0x46, 0x0a,
-	0x44, 0x2a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x32, 0x23, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x3a, 0x2a, 0x92, 0x41, 0x27, 0x0a, 0x25, 0x2a, 0x0d, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x14, 0x4d, 0x70, 0x65, 0x73, 0x61,
-	0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0xe1, 0x03, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x69,
-	0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x70, 0x61, 0x72,
-	0x74, 0x79, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x72,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x22,
-	0x69, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x53, 0x49, 0x53, 0x44, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
-	0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a,
-	0x17, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48,
-	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x3a, 0x47, 0x92, 0x41, 0x44, 0x0a,
-	0x42, 0x2a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x20,
-	0x66, 0x6f, 0x72, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x22, 0xf7, 0x02, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x75, 0x6e,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x32, 0x0a,
-	0x15, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x75, 0x74,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64,
-	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69,
-	0x64, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x63,
-	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x3a,
-	0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0x2a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x32, 0x28, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x64, 0x61, 0x74, 0x61, 0x22, 0x83, 0x03,
-	0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09,
-	0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x72, 0x65, 0x6d,
-	0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x69, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e,
-	0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x3a, 0x46, 0x92, 0x41, 0x43,
-	0x0a, 0x41, 0x2a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x24, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x20, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x2a, 0x67, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53,
-	0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12,
-	0x14, 0x0a, 0x10, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x41, 0x59, 0x4d,
-	0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x2a, 0x76, 0x0a, 0x09,
-	0x42, 0x32, 0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x32, 0x43,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x32, 0x43, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
-	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x32, 0x43,
-	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x32, 0x43, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
-	0x53, 0x53, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x32, 0x43, 0x5f, 0x46, 0x41, 0x49, 0x4c,
-	0x45, 0x44, 0x10, 0x04, 0x2a, 0x65, 0x0a, 0x0e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x32, 0x43, 0x50, 0x41, 0x59,
-	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10,
-	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x32, 0x43, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
-	0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x1b,
-	0x0a, 0x17, 0x42, 0x32, 0x43, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x5f,
-	0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x2a, 0x63, 0x0a, 0x0d, 0x42,
-	0x32, 0x43, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x1b,
-	0x42, 0x32, 0x43, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a,
-	0x0e, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10,
-	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x42, 0x32, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x02,
-	0x2a, 0x60, 0x0a, 0x11, 0x42, 0x32, 0x43, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x52, 0x4f,
-	0x43, 0x45, 0x53, 0x53, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x5f,
-	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x42,
-	0x32, 0x43, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44,
-	0x10, 0x02, 0x32, 0xaf, 0x09, 0x0a, 0x05, 0x42, 0x32, 0x43, 0x56, 0x31, 0x12, 0x82, 0x01, 0x0a,
-	0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x26,
-	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
-	0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31,
-	0x3a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x3a, 0x01,
-	0x2a, 0x12, 0x73, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
-	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
-	0x12, 0x14, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79,
-	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x12,
-	0x7d, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
-	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42,
-	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x22, 0x19, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7d,
-	0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
-	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x32,
-	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22,
-	0x19, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a,
-	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
-	0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1b, 0x22, 0x16, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x44,
-	0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x95, 0x01, 0x0a,
-	0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x23, 0x22, 0x1e, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2c, 0x2e, 0x67,
-	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x69, 0x64,
-	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x20, 0x22, 0x1b, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x3a, 0x01,
-	0x2a, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
-	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x72,
-	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x3a, 0x01, 0x2a, 0x42, 0xc6, 0x03, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61,
-	0x62, 0x32, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x32, 0x63, 0x2f,
-	0x76, 0x31, 0x3b, 0x62, 0x32, 0x63, 0x5f, 0x76, 0x31, 0x92, 0x41, 0x90, 0x03, 0x12, 0xfc, 0x01,
-	0x0a, 0x11, 0x42, 0x32, 0x43, 0x20, 0x4d, 0x70, 0x65, 0x73, 0x61, 0x20, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x11, 0x42, 0x32, 0x43, 0x20, 0x41, 0x50, 0x69, 0x20, 0x66, 0x6f, 0x72,
-	0x20, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x22, 0x76, 0x0a, 0x15, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x20, 0x3c, 0x47, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x20, 0x4b, 0x61, 0x6d, 0x61, 0x75, 0x3e, 0x12,
-	0x46, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x62, 0x69, 0x74, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x6b, 0x61, 0x6d,
-	0x61, 0x75, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x62, 0x32, 0x63, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x32, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x68,
-	0x61, 0x63, 0x65, 0x72, 0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2a, 0x58,
-	0x0a, 0x1a, 0x47, 0x4e, 0x55, 0x20, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x20, 0x50, 0x55,
-	0x42, 0x4c, 0x49, 0x43, 0x20, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x12, 0x3a, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x32, 0x02, 0x76, 0x31, 0x2a, 0x02, 0x01, 0x02,
-	0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x59, 0x0a, 0x57, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72,
-	0x12, 0x4d, 0x08, 0x02, 0x12, 0x38, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2c, 0x20, 0x70, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x3a, 0x20,
-	0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3e, 0x1a, 0x0d,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x02, 0x62,
-	0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_b2c_v1_proto_rawDescOnce sync.Once
-	file_b2c_v1_proto_rawDescData = file_b2c_v1_proto_rawDesc
-)
-
-func file_b2c_v1_proto_rawDescGZIP() []byte {
-	file_b2c_v1_proto_rawDescOnce.Do(func() {
-		file_b2c_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_b2c_v1_proto_rawDescData)
-	})
-	return file_b2c_v1_proto_rawDescData
-}
-
-var file_b2c_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_b2c_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
-var file_b2c_v1_proto_goTypes = []interface{}{
-	(CommandId)(0),         // 0: gidyon.mpesa.b2c.CommandId
-	(B2CStatus)(0),         // 1: gidyon.mpesa.b2c.B2CStatus
-	(B2CPaymentView)(0),    // 2: gidyon.mpesa.b2c.B2CPaymentView
-	(B2COrderField)(0),     // 3: gidyon.mpesa.b2c.B2COrderField
-	(B2CProcessedState)(0), // 4: gidyon.mpesa.b2c.B2CProcessedState
-	(QueryTransactionStatusRequest_IdentifierType)(0), // 5: gidyon.mpesa.b2c.QueryTransactionStatusRequest.IdentifierType
-	(QueryAccountBalanceRequest_IdentifierType)(0),    // 6: gidyon.mpesa.b2c.QueryAccountBalanceRequest.IdentifierType
-	(*TransferFundsRequest)(nil),                      // 7: gidyon.mpesa.b2c.TransferFundsRequest
-	(*TransferFundsResponse)(nil),                     // 8: gidyon.mpesa.b2c.TransferFundsResponse
-	(*PublishMessage)(nil),                            // 9: gidyon.mpesa.b2c.PublishMessage
-	(*PublishInfo)(nil),                               // 10: gidyon.mpesa.b2c.PublishInfo
-	(*B2CPayment)(nil),                                // 11: gidyon.mpesa.b2c.B2CPayment
-	(*GetB2CPaymentRequest)(nil),                      // 12: gidyon.mpesa.b2c.GetB2CPaymentRequest
-	(*ListB2CPaymentFilter)(nil),                      // 13: gidyon.mpesa.b2c.ListB2CPaymentFilter
-	(*ListB2CPaymentsRequest)(nil),                    // 14: gidyon.mpesa.b2c.ListB2CPaymentsRequest
-	(*ListB2CPaymentsResponse)(nil),                   // 15: gidyon.mpesa.b2c.ListB2CPaymentsResponse
-	(*ProcessB2CPaymentRequest)(nil),                  // 16: gidyon.mpesa.b2c.ProcessB2CPaymentRequest
-	(*PublishB2CPaymentRequest)(nil),                  // 17: gidyon.mpesa.b2c.PublishB2CPaymentRequest
-	(*DailyStat)(nil),                                 // 18: gidyon.mpesa.b2c.DailyStat
-	(*StatsResponse)(nil),                             // 19: gidyon.mpesa.b2c.StatsResponse
-	(*ListStatsFilter)(nil),                           // 20: gidyon.mpesa.b2c.ListStatsFilter
-	(*ListDailyStatsRequest)(nil),                     // 21: gidyon.mpesa.b2c.ListDailyStatsRequest
-	(*QueryTransactionStatusRequest)(nil),             // 22: gidyon.mpesa.b2c.QueryTransactionStatusRequest
-	(*QueryResponse)(nil),                             // 23: gidyon.mpesa.b2c.QueryResponse
-	(*QueryAccountBalanceRequest)(nil),                // 24: gidyon.mpesa.b2c.QueryAccountBalanceRequest
-	(*QueryAccountBalanceResponse)(nil),               // 25: gidyon.mpesa.b2c.QueryAccountBalanceResponse
-	(*ReverseTransactionRequest)(nil),                 // 26: gidyon.mpesa.b2c.ReverseTransactionRequest
-	nil,                                               // 27: gidyon.mpesa.b2c.PublishInfo.PayloadEntry
-	(*emptypb.Empty)(nil),                             // 28: google.protobuf.Empty
-}
-var file_b2c_v1_proto_depIdxs = []int32{
-	0,  // 0: gidyon.mpesa.b2c.TransferFundsRequest.command_id:type_name -> gidyon.mpesa.b2c.CommandId
-	10, // 1: gidyon.mpesa.b2c.TransferFundsRequest.publish_message:type_name -> gidyon.mpesa.b2c.PublishInfo
-	10, // 2: gidyon.mpesa.b2c.PublishMessage.publish_info:type_name -> gidyon.mpesa.b2c.PublishInfo
-	11, // 3: gidyon.mpesa.b2c.PublishMessage.payment:type_name -> gidyon.mpesa.b2c.B2CPayment
-	27, // 4: gidyon.mpesa.b2c.PublishInfo.payload:type_name -> gidyon.mpesa.b2c.PublishInfo.PayloadEntry
-	0,  // 5: gidyon.mpesa.b2c.B2CPayment.command_id:type_name -> gidyon.mpesa.b2c.CommandId
-	1,  // 6: gidyon.mpesa.b2c.B2CPayment.b2c_status:type_name -> gidyon.mpesa.b2c.B2CStatus
-	2,  // 7: gidyon.mpesa.b2c.GetB2CPaymentRequest.view:type_name -> gidyon.mpesa.b2c.B2CPaymentView
-	1,  // 8: gidyon.mpesa.b2c.ListB2CPaymentFilter.b2c_statuses:type_name -> gidyon.mpesa.b2c.B2CStatus
-	4,  // 9: gidyon.mpesa.b2c.ListB2CPaymentFilter.process_state:type_name -> gidyon.mpesa.b2c.B2CProcessedState
-	3,  // 10: gidyon.mpesa.b2c.ListB2CPaymentFilter.order_field:type_name -> gidyon.mpesa.b2c.B2COrderField
-	2,  // 11: gidyon.mpesa.b2c.ListB2CPaymentsRequest.view:type_name -> gidyon.mpesa.b2c.B2CPaymentView
-	13, // 12: gidyon.mpesa.b2c.ListB2CPaymentsRequest.filter:type_name -> gidyon.mpesa.b2c.ListB2CPaymentFilter
-	11, // 13: gidyon.mpesa.b2c.ListB2CPaymentsResponse.b2c_payments:type_name -> gidyon.mpesa.b2c.B2CPayment
-	9,  // 14: gidyon.mpesa.b2c.PublishB2CPaymentRequest.publish_message:type_name -> gidyon.mpesa.b2c.PublishMessage
-	4,  // 15: gidyon.mpesa.b2c.PublishB2CPaymentRequest.processed_state:type_name -> gidyon.mpesa.b2c.B2CProcessedState
-	18, // 16: gidyon.mpesa.b2c.StatsResponse.stats:type_name -> gidyon.mpesa.b2c.DailyStat
-	20, // 17: gidyon.mpesa.b2c.ListDailyStatsRequest.filter:type_name -> gidyon.mpesa.b2c.ListStatsFilter
-	5,  // 18: gidyon.mpesa.b2c.QueryTransactionStatusRequest.identifier_type:type_name -> gidyon.mpesa.b2c.QueryTransactionStatusRequest.IdentifierType
-	6,  // 19: gidyon.mpesa.b2c.QueryAccountBalanceRequest.identifier_type:type_name -> gidyon.mpesa.b2c.QueryAccountBalanceRequest.IdentifierType
-	7,  // 20: gidyon.mpesa.b2c.B2CV1.TransferFunds:input_type -> gidyon.mpesa.b2c.TransferFundsRequest
-	12, // 21: gidyon.mpesa.b2c.B2CV1.GetB2CPayment:input_type -> gidyon.mpesa.b2c.GetB2CPaymentRequest
-	14, // 22: gidyon.mpesa.b2c.B2CV1.ListB2CPayments:input_type -> gidyon.mpesa.b2c.ListB2CPaymentsRequest
-	16, // 23: gidyon.mpesa.b2c.B2CV1.ProcessB2CPayment:input_type -> gidyon.mpesa.b2c.ProcessB2CPaymentRequest
-	17, // 24: gidyon.mpesa.b2c.B2CV1.PublishB2CPayment:input_type -> gidyon.mpesa.b2c.PublishB2CPaymentRequest
-	21, // 25: gidyon.mpesa.b2c.B2CV1.ListDailyStats:input_type -> gidyon.mpesa.b2c.ListDailyStatsRequest
-	22, // 26: gidyon.mpesa.b2c.B2CV1.QueryTransactionStatus:input_type -> gidyon.mpesa.b2c.QueryTransactionStatusRequest
-	24, // 27: gidyon.mpesa.b2c.B2CV1.QueryAccountBalance:input_type -> gidyon.mpesa.b2c.QueryAccountBalanceRequest
-	26, // 28: gidyon.mpesa.b2c.B2CV1.ReverseTransaction:input_type -> gidyon.mpesa.b2c.ReverseTransactionRequest
-	8,  // 29: gidyon.mpesa.b2c.B2CV1.TransferFunds:output_type -> gidyon.mpesa.b2c.TransferFundsResponse
-	11, // 30: gidyon.mpesa.b2c.B2CV1.GetB2CPayment:output_type -> gidyon.mpesa.b2c.B2CPayment
-	15, // 31: gidyon.mpesa.b2c.B2CV1.ListB2CPayments:output_type -> gidyon.mpesa.b2c.ListB2CPaymentsResponse
-	28, // 32: gidyon.mpesa.b2c.B2CV1.ProcessB2CPayment:output_type -> google.protobuf.Empty
-	28, // 33: gidyon.mpesa.b2c.B2CV1.PublishB2CPayment:output_type -> google.protobuf.Empty
-	19, // 34: gidyon.mpesa.b2c.B2CV1.ListDailyStats:output_type -> gidyon.mpesa.b2c.StatsResponse
-	23, // 35: gidyon.mpesa.b2c.B2CV1.QueryTransactionStatus:output_type -> gidyon.mpesa.b2c.QueryResponse
-	25, // 36: gidyon.mpesa.b2c.B2CV1.QueryAccountBalance:output_type -> gidyon.mpesa.b2c.QueryAccountBalanceResponse
-	28, // 37: gidyon.mpesa.b2c.B2CV1.ReverseTransaction:output_type -> google.protobuf.Empty
-	29, // [29:38] is the sub-list for method output_type
-	20, // [20:29] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
-}
-
-func init() { file_b2c_v1_proto_init() }
-func file_b2c_v1_proto_init() {
-	if File_b2c_v1_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_b2c_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferFundsRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportB2CPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ExportB2CPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportB2CPaymentsRequest) GetFilter() *ListB2CPaymentFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportB2CPaymentsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportB2CPaymentsRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+type ExportB2CPaymentsChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName    string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Data        []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportB2CPaymentsChunk) Reset() {
+	*x = ExportB2CPaymentsChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportB2CPaymentsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportB2CPaymentsChunk) ProtoMessage() {}
+
+func (x *ExportB2CPaymentsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportB2CPaymentsChunk.ProtoReflect.Descriptor instead.
+func (*ExportB2CPaymentsChunk) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportB2CPaymentsChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportB2CPaymentsChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportB2CPaymentsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WatchB2CPaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter      *ListB2CPaymentFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ResumeToken string                `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *WatchB2CPaymentsRequest) Reset() {
+	*x = WatchB2CPaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchB2CPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchB2CPaymentsRequest) ProtoMessage() {}
+
+func (x *WatchB2CPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchB2CPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*WatchB2CPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WatchB2CPaymentsRequest) GetFilter() *ListB2CPaymentFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchB2CPaymentsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type WatchB2CPaymentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResumeToken string      `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Payment     *B2CPayment `protobuf:"bytes,2,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *WatchB2CPaymentsResponse) Reset() {
+	*x = WatchB2CPaymentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchB2CPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchB2CPaymentsResponse) ProtoMessage() {}
+
+func (x *WatchB2CPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchB2CPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*WatchB2CPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WatchB2CPaymentsResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *WatchB2CPaymentsResponse) GetPayment() *B2CPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type ProcessB2CPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Processed bool   `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
+}
+
+func (x *ProcessB2CPaymentRequest) Reset() {
+	*x = ProcessB2CPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessB2CPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessB2CPaymentRequest) ProtoMessage() {}
+
+func (x *ProcessB2CPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessB2CPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ProcessB2CPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProcessB2CPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ProcessB2CPaymentRequest) GetProcessed() bool {
+	if x != nil {
+		return x.Processed
+	}
+	return false
+}
+
+type PublishB2CPaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublishMessage *PublishMessage   `protobuf:"bytes,1,opt,name=publish_message,json=publishMessage,proto3" json:"publish_message,omitempty"`
+	ProcessedState B2CProcessedState `protobuf:"varint,2,opt,name=processed_state,json=processedState,proto3,enum=gidyon.mpesa.b2c.B2CProcessedState" json:"processed_state,omitempty"`
+}
+
+func (x *PublishB2CPaymentRequest) Reset() {
+	*x = PublishB2CPaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishB2CPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishB2CPaymentRequest) ProtoMessage() {}
+
+func (x *PublishB2CPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishB2CPaymentRequest.ProtoReflect.Descriptor instead.
+func (*PublishB2CPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PublishB2CPaymentRequest) GetPublishMessage() *PublishMessage {
+	if x != nil {
+		return x.PublishMessage
+	}
+	return nil
+}
+
+func (x *PublishB2CPaymentRequest) GetProcessedState() B2CProcessedState {
+	if x != nil {
+		return x.ProcessedState
+	}
+	return B2CProcessedState_B2C_PROCESS_STATE_UNSPECIFIED
+}
+
+type DailyStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatId                       string           `protobuf:"bytes,1,opt,name=stat_id,json=statId,proto3" json:"stat_id,omitempty"`
+	Date                         string           `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	OrgShortCode                 string           `protobuf:"bytes,3,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
+	TotalTransactions            int32            `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	SuccessfulTransactions       int64            `protobuf:"varint,5,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
+	FailedTransactions           int64            `protobuf:"varint,6,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
+	TotalAmountTransacted        float32          `protobuf:"fixed32,7,opt,name=total_amount_transacted,json=totalAmountTransacted,proto3" json:"total_amount_transacted,omitempty"`
+	TotalCharges                 float32          `protobuf:"fixed32,8,opt,name=total_charges,json=totalCharges,proto3" json:"total_charges,omitempty"`
+	CreateTimeSeconds            int64            `protobuf:"varint,9,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+	UpdateTimeSeconds            int64            `protobuf:"varint,10,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+	TotalMpesaCharges            float32          `protobuf:"fixed32,11,opt,name=total_mpesa_charges,json=totalMpesaCharges,proto3" json:"total_mpesa_charges,omitempty"`
+	UniqueRecipients             int64            `protobuf:"varint,12,opt,name=unique_recipients,json=uniqueRecipients,proto3" json:"unique_recipients,omitempty"`
+	PendingTransactions          int64            `protobuf:"varint,13,opt,name=pending_transactions,json=pendingTransactions,proto3" json:"pending_transactions,omitempty"`
+	UnknownTransactions          int64            `protobuf:"varint,14,opt,name=unknown_transactions,json=unknownTransactions,proto3" json:"unknown_transactions,omitempty"`
+	CommandStats                 []*CommandIdStat `protobuf:"bytes,15,rep,name=command_stats,json=commandStats,proto3" json:"command_stats,omitempty"`
+	AverageCallbackLatencyMillis int64            `protobuf:"varint,16,opt,name=average_callback_latency_millis,json=averageCallbackLatencyMillis,proto3" json:"average_callback_latency_millis,omitempty"`
+	P95CallbackLatencyMillis     int64            `protobuf:"varint,17,opt,name=p95_callback_latency_millis,json=p95CallbackLatencyMillis,proto3" json:"p95_callback_latency_millis,omitempty"`
+}
+
+func (x *DailyStat) Reset() {
+	*x = DailyStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyStat) ProtoMessage() {}
+
+func (x *DailyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyStat.ProtoReflect.Descriptor instead.
+func (*DailyStat) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DailyStat) GetStatId() string {
+	if x != nil {
+		return x.StatId
+	}
+	return ""
+}
+
+func (x *DailyStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DailyStat) GetOrgShortCode() string {
+	if x != nil {
+		return x.OrgShortCode
+	}
+	return ""
+}
+
+func (x *DailyStat) GetTotalTransactions() int32 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *DailyStat) GetSuccessfulTransactions() int64 {
+	if x != nil {
+		return x.SuccessfulTransactions
+	}
+	return 0
+}
+
+func (x *DailyStat) GetFailedTransactions() int64 {
+	if x != nil {
+		return x.FailedTransactions
+	}
+	return 0
+}
+
+func (x *DailyStat) GetTotalAmountTransacted() float32 {
+	if x != nil {
+		return x.TotalAmountTransacted
+	}
+	return 0
+}
+
+func (x *DailyStat) GetTotalCharges() float32 {
+	if x != nil {
+		return x.TotalCharges
+	}
+	return 0
+}
+
+func (x *DailyStat) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+func (x *DailyStat) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+func (x *DailyStat) GetTotalMpesaCharges() float32 {
+	if x != nil {
+		return x.TotalMpesaCharges
+	}
+	return 0
+}
+
+func (x *DailyStat) GetUniqueRecipients() int64 {
+	if x != nil {
+		return x.UniqueRecipients
+	}
+	return 0
+}
+
+func (x *DailyStat) GetPendingTransactions() int64 {
+	if x != nil {
+		return x.PendingTransactions
+	}
+	return 0
+}
+
+func (x *DailyStat) GetUnknownTransactions() int64 {
+	if x != nil {
+		return x.UnknownTransactions
+	}
+	return 0
+}
+
+func (x *DailyStat) GetCommandStats() []*CommandIdStat {
+	if x != nil {
+		return x.CommandStats
+	}
+	return nil
+}
+
+func (x *DailyStat) GetAverageCallbackLatencyMillis() int64 {
+	if x != nil {
+		return x.AverageCallbackLatencyMillis
+	}
+	return 0
+}
+
+func (x *DailyStat) GetP95CallbackLatencyMillis() int64 {
+	if x != nil {
+		return x.P95CallbackLatencyMillis
+	}
+	return 0
+}
+
+type CommandIdStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId              CommandId `protobuf:"varint,1,opt,name=command_id,json=commandId,proto3,enum=gidyon.mpesa.b2c.CommandId" json:"command_id,omitempty"`
+	TotalTransactions      int64     `protobuf:"varint,2,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	SuccessfulTransactions int64     `protobuf:"varint,3,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
+	TotalAmountTransacted  float32   `protobuf:"fixed32,4,opt,name=total_amount_transacted,json=totalAmountTransacted,proto3" json:"total_amount_transacted,omitempty"`
+}
+
+func (x *CommandIdStat) Reset() {
+	*x = CommandIdStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandIdStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandIdStat) ProtoMessage() {}
+
+func (x *CommandIdStat) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandIdStat.ProtoReflect.Descriptor instead.
+func (*CommandIdStat) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CommandIdStat) GetCommandId() CommandId {
+	if x != nil {
+		return x.CommandId
+	}
+	return CommandId_COMMANDID_UNSPECIFIED
+}
+
+func (x *CommandIdStat) GetTotalTransactions() int64 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *CommandIdStat) GetSuccessfulTransactions() int64 {
+	if x != nil {
+		return x.SuccessfulTransactions
+	}
+	return 0
+}
+
+func (x *CommandIdStat) GetTotalAmountTransacted() float32 {
+	if x != nil {
+		return x.TotalAmountTransacted
+	}
+	return 0
+}
+
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats         []*DailyStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StatsResponse) GetStats() []*DailyStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListStatsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationShortCodes []string `protobuf:"bytes,1,rep,name=organization_short_codes,json=organizationShortCodes,proto3" json:"organization_short_codes,omitempty"`
+	StartTimeSeconds       int64    `protobuf:"varint,2,opt,name=start_time_seconds,json=startTimeSeconds,proto3" json:"start_time_seconds,omitempty"`
+	EndTimeSeconds         int64    `protobuf:"varint,3,opt,name=end_time_seconds,json=endTimeSeconds,proto3" json:"end_time_seconds,omitempty"`
+	TxDates                []string `protobuf:"bytes,4,rep,name=tx_dates,json=txDates,proto3" json:"tx_dates,omitempty"`
+}
+
+func (x *ListStatsFilter) Reset() {
+	*x = ListStatsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStatsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStatsFilter) ProtoMessage() {}
+
+func (x *ListStatsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStatsFilter.ProtoReflect.Descriptor instead.
+func (*ListStatsFilter) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListStatsFilter) GetOrganizationShortCodes() []string {
+	if x != nil {
+		return x.OrganizationShortCodes
+	}
+	return nil
+}
+
+func (x *ListStatsFilter) GetStartTimeSeconds() int64 {
+	if x != nil {
+		return x.StartTimeSeconds
+	}
+	return 0
+}
+
+func (x *ListStatsFilter) GetEndTimeSeconds() int64 {
+	if x != nil {
+		return x.EndTimeSeconds
+	}
+	return 0
+}
+
+func (x *ListStatsFilter) GetTxDates() []string {
+	if x != nil {
+		return x.TxDates
+	}
+	return nil
+}
+
+type ListDailyStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string           `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListStatsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListDailyStatsRequest) Reset() {
+	*x = ListDailyStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDailyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDailyStatsRequest) ProtoMessage() {}
+
+func (x *ListDailyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDailyStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListDailyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListDailyStatsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDailyStatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDailyStatsRequest) GetFilter() *ListStatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type HourlyStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatId                 string  `protobuf:"bytes,1,opt,name=stat_id,json=statId,proto3" json:"stat_id,omitempty"`
+	Hour                   string  `protobuf:"bytes,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	OrgShortCode           string  `protobuf:"bytes,3,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
+	TotalTransactions      int64   `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	SuccessfulTransactions int64   `protobuf:"varint,5,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
+	FailedTransactions     int64   `protobuf:"varint,6,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
+	TotalAmountTransacted  float32 `protobuf:"fixed32,7,opt,name=total_amount_transacted,json=totalAmountTransacted,proto3" json:"total_amount_transacted,omitempty"`
+	TotalCharges           float32 `protobuf:"fixed32,8,opt,name=total_charges,json=totalCharges,proto3" json:"total_charges,omitempty"`
+	StartTimeSeconds       int64   `protobuf:"varint,9,opt,name=start_time_seconds,json=startTimeSeconds,proto3" json:"start_time_seconds,omitempty"`
+	CreateTimeSeconds      int64   `protobuf:"varint,10,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+	UpdateTimeSeconds      int64   `protobuf:"varint,11,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+}
+
+func (x *HourlyStat) Reset() {
+	*x = HourlyStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HourlyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourlyStat) ProtoMessage() {}
+
+func (x *HourlyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourlyStat.ProtoReflect.Descriptor instead.
+func (*HourlyStat) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *HourlyStat) GetStatId() string {
+	if x != nil {
+		return x.StatId
+	}
+	return ""
+}
+
+func (x *HourlyStat) GetHour() string {
+	if x != nil {
+		return x.Hour
+	}
+	return ""
+}
+
+func (x *HourlyStat) GetOrgShortCode() string {
+	if x != nil {
+		return x.OrgShortCode
+	}
+	return ""
+}
+
+func (x *HourlyStat) GetTotalTransactions() int64 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetSuccessfulTransactions() int64 {
+	if x != nil {
+		return x.SuccessfulTransactions
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetFailedTransactions() int64 {
+	if x != nil {
+		return x.FailedTransactions
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetTotalAmountTransacted() float32 {
+	if x != nil {
+		return x.TotalAmountTransacted
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetTotalCharges() float32 {
+	if x != nil {
+		return x.TotalCharges
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetStartTimeSeconds() int64 {
+	if x != nil {
+		return x.StartTimeSeconds
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+func (x *HourlyStat) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+type ListHourlyStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string           `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListStatsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListHourlyStatsRequest) Reset() {
+	*x = ListHourlyStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHourlyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHourlyStatsRequest) ProtoMessage() {}
+
+func (x *ListHourlyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHourlyStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListHourlyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListHourlyStatsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListHourlyStatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListHourlyStatsRequest) GetFilter() *ListStatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListHourlyStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats         []*HourlyStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListHourlyStatsResponse) Reset() {
+	*x = ListHourlyStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHourlyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHourlyStatsResponse) ProtoMessage() {}
+
+func (x *ListHourlyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHourlyStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListHourlyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListHourlyStatsResponse) GetStats() []*HourlyStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ListHourlyStatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MonthlyStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatId                 string  `protobuf:"bytes,1,opt,name=stat_id,json=statId,proto3" json:"stat_id,omitempty"`
+	Month                  string  `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	OrgShortCode           string  `protobuf:"bytes,3,opt,name=org_short_code,json=orgShortCode,proto3" json:"org_short_code,omitempty"`
+	TotalTransactions      int64   `protobuf:"varint,4,opt,name=total_transactions,json=totalTransactions,proto3" json:"total_transactions,omitempty"`
+	SuccessfulTransactions int64   `protobuf:"varint,5,opt,name=successful_transactions,json=successfulTransactions,proto3" json:"successful_transactions,omitempty"`
+	FailedTransactions     int64   `protobuf:"varint,6,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
+	TotalAmountTransacted  float32 `protobuf:"fixed32,7,opt,name=total_amount_transacted,json=totalAmountTransacted,proto3" json:"total_amount_transacted,omitempty"`
+	TotalCharges           float32 `protobuf:"fixed32,8,opt,name=total_charges,json=totalCharges,proto3" json:"total_charges,omitempty"`
+	StartTimeSeconds       int64   `protobuf:"varint,9,opt,name=start_time_seconds,json=startTimeSeconds,proto3" json:"start_time_seconds,omitempty"`
+	CreateTimeSeconds      int64   `protobuf:"varint,10,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+	UpdateTimeSeconds      int64   `protobuf:"varint,11,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+}
+
+func (x *MonthlyStat) Reset() {
+	*x = MonthlyStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonthlyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyStat) ProtoMessage() {}
+
+func (x *MonthlyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyStat.ProtoReflect.Descriptor instead.
+func (*MonthlyStat) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MonthlyStat) GetStatId() string {
+	if x != nil {
+		return x.StatId
+	}
+	return ""
+}
+
+func (x *MonthlyStat) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthlyStat) GetOrgShortCode() string {
+	if x != nil {
+		return x.OrgShortCode
+	}
+	return ""
+}
+
+func (x *MonthlyStat) GetTotalTransactions() int64 {
+	if x != nil {
+		return x.TotalTransactions
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetSuccessfulTransactions() int64 {
+	if x != nil {
+		return x.SuccessfulTransactions
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetFailedTransactions() int64 {
+	if x != nil {
+		return x.FailedTransactions
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetTotalAmountTransacted() float32 {
+	if x != nil {
+		return x.TotalAmountTransacted
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetTotalCharges() float32 {
+	if x != nil {
+		return x.TotalCharges
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetStartTimeSeconds() int64 {
+	if x != nil {
+		return x.StartTimeSeconds
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+func (x *MonthlyStat) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+type ListMonthlyStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string           `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32            `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Filter    *ListStatsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListMonthlyStatsRequest) Reset() {
+	*x = ListMonthlyStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMonthlyStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonthlyStatsRequest) ProtoMessage() {}
+
+func (x *ListMonthlyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonthlyStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListMonthlyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMonthlyStatsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMonthlyStatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMonthlyStatsRequest) GetFilter() *ListStatsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ListMonthlyStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats         []*MonthlyStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListMonthlyStatsResponse) Reset() {
+	*x = ListMonthlyStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMonthlyStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMonthlyStatsResponse) ProtoMessage() {}
+
+func (x *ListMonthlyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMonthlyStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListMonthlyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListMonthlyStatsResponse) GetStats() []*MonthlyStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ListMonthlyStatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RegenerateStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dates are inclusive and in the format 2006-01-02
+	StartDate  string   `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate    string   `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ShortCodes []string `protobuf:"bytes,3,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
+	SkipHourly bool     `protobuf:"varint,4,opt,name=skip_hourly,json=skipHourly,proto3" json:"skip_hourly,omitempty"`
+}
+
+func (x *RegenerateStatsRequest) Reset() {
+	*x = RegenerateStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegenerateStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateStatsRequest) ProtoMessage() {}
+
+func (x *RegenerateStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateStatsRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateStatsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RegenerateStatsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *RegenerateStatsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *RegenerateStatsRequest) GetShortCodes() []string {
+	if x != nil {
+		return x.ShortCodes
+	}
+	return nil
+}
+
+func (x *RegenerateStatsRequest) GetSkipHourly() bool {
+	if x != nil {
+		return x.SkipHourly
+	}
+	return false
+}
+
+type StatsJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId             string         `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	StartDate         string         `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           string         `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ShortCodes        []string       `protobuf:"bytes,4,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
+	SkipHourly        bool           `protobuf:"varint,5,opt,name=skip_hourly,json=skipHourly,proto3" json:"skip_hourly,omitempty"`
+	Status            StatsJobStatus `protobuf:"varint,6,opt,name=status,proto3,enum=gidyon.mpesa.b2c.StatsJobStatus" json:"status,omitempty"`
+	DaysTotal         int32          `protobuf:"varint,7,opt,name=days_total,json=daysTotal,proto3" json:"days_total,omitempty"`
+	DaysDone          int32          `protobuf:"varint,8,opt,name=days_done,json=daysDone,proto3" json:"days_done,omitempty"`
+	Error             string         `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	RequestedBy       string         `protobuf:"bytes,10,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	StartTimeSeconds  int64          `protobuf:"varint,11,opt,name=start_time_seconds,json=startTimeSeconds,proto3" json:"start_time_seconds,omitempty"`
+	EndTimeSeconds    int64          `protobuf:"varint,12,opt,name=end_time_seconds,json=endTimeSeconds,proto3" json:"end_time_seconds,omitempty"`
+	CreateTimeSeconds int64          `protobuf:"varint,13,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+	UpdateTimeSeconds int64          `protobuf:"varint,14,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+}
+
+func (x *StatsJob) Reset() {
+	*x = StatsJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsJob) ProtoMessage() {}
+
+func (x *StatsJob) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsJob.ProtoReflect.Descriptor instead.
+func (*StatsJob) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StatsJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *StatsJob) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *StatsJob) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *StatsJob) GetShortCodes() []string {
+	if x != nil {
+		return x.ShortCodes
+	}
+	return nil
+}
+
+func (x *StatsJob) GetSkipHourly() bool {
+	if x != nil {
+		return x.SkipHourly
+	}
+	return false
+}
+
+func (x *StatsJob) GetStatus() StatsJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return StatsJobStatus_STATS_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *StatsJob) GetDaysTotal() int32 {
+	if x != nil {
+		return x.DaysTotal
+	}
+	return 0
+}
+
+func (x *StatsJob) GetDaysDone() int32 {
+	if x != nil {
+		return x.DaysDone
+	}
+	return 0
+}
+
+func (x *StatsJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *StatsJob) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *StatsJob) GetStartTimeSeconds() int64 {
+	if x != nil {
+		return x.StartTimeSeconds
+	}
+	return 0
+}
+
+func (x *StatsJob) GetEndTimeSeconds() int64 {
+	if x != nil {
+		return x.EndTimeSeconds
+	}
+	return 0
+}
+
+func (x *StatsJob) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+func (x *StatsJob) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+type GetStatsJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+}
+
+func (x *GetStatsJobRequest) Reset() {
+	*x = GetStatsJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsJobRequest) ProtoMessage() {}
+
+func (x *GetStatsJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsJobRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsJobRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetStatsJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type QueryTransactionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdentifierType     QueryTransactionStatusRequest_IdentifierType `protobuf:"varint,1,opt,name=identifier_type,json=identifierType,proto3,enum=gidyon.mpesa.b2c.QueryTransactionStatusRequest_IdentifierType" json:"identifier_type,omitempty"`
+	PartyA             int64                                        `protobuf:"varint,2,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
+	Remarks            string                                       `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	Initiator          string                                       `protobuf:"bytes,4,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	SecurityCredential string                                       `protobuf:"bytes,5,opt,name=security_credential,json=securityCredential,proto3" json:"security_credential,omitempty"`
+	QueueTimeoutUrl    string                                       `protobuf:"bytes,6,opt,name=queue_timeout_url,json=queueTimeoutUrl,proto3" json:"queue_timeout_url,omitempty"`
+	ResultUrl          string                                       `protobuf:"bytes,7,opt,name=result_url,json=resultUrl,proto3" json:"result_url,omitempty"`
+	TransactionId      string                                       `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Occassion          string                                       `protobuf:"bytes,9,opt,name=occassion,proto3" json:"occassion,omitempty"`
+	Synchronous        bool                                         `protobuf:"varint,10,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+}
+
+func (x *QueryTransactionStatusRequest) Reset() {
+	*x = QueryTransactionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTransactionStatusRequest) ProtoMessage() {}
+
+func (x *QueryTransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*QueryTransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *QueryTransactionStatusRequest) GetIdentifierType() QueryTransactionStatusRequest_IdentifierType {
+	if x != nil {
+		return x.IdentifierType
+	}
+	return QueryTransactionStatusRequest_QUERY_TRANSACTION_UNSPECIFIED
+}
+
+func (x *QueryTransactionStatusRequest) GetPartyA() int64 {
+	if x != nil {
+		return x.PartyA
+	}
+	return 0
+}
+
+func (x *QueryTransactionStatusRequest) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetInitiator() string {
+	if x != nil {
+		return x.Initiator
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetSecurityCredential() string {
+	if x != nil {
+		return x.SecurityCredential
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetQueueTimeoutUrl() string {
+	if x != nil {
+		return x.QueueTimeoutUrl
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetResultUrl() string {
+	if x != nil {
+		return x.ResultUrl
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetOccassion() string {
+	if x != nil {
+		return x.Occassion
+	}
+	return ""
+}
+
+func (x *QueryTransactionStatusRequest) GetSynchronous() bool {
+	if x != nil {
+		return x.Synchronous
+	}
+	return false
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OriginatorConversionId string `protobuf:"bytes,1,opt,name=originator_conversion_id,json=originatorConversionId,proto3" json:"originator_conversion_id,omitempty"`
+	ConversionId           string `protobuf:"bytes,2,opt,name=conversion_id,json=conversionId,proto3" json:"conversion_id,omitempty"`
+	ResponseDescription    string `protobuf:"bytes,3,opt,name=response_description,json=responseDescription,proto3" json:"response_description,omitempty"`
+	Success                bool   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *QueryResponse) GetOriginatorConversionId() string {
+	if x != nil {
+		return x.OriginatorConversionId
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetConversionId() string {
+	if x != nil {
+		return x.ConversionId
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetResponseDescription() string {
+	if x != nil {
+		return x.ResponseDescription
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type QueryAccountBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdentifierType QueryAccountBalanceRequest_IdentifierType `protobuf:"varint,1,opt,name=identifier_type,json=identifierType,proto3,enum=gidyon.mpesa.b2c.QueryAccountBalanceRequest_IdentifierType" json:"identifier_type,omitempty"`
+	PartyA         int64                                     `protobuf:"varint,2,opt,name=party_a,json=partyA,proto3" json:"party_a,omitempty"`
+	Remarks        string                                    `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	InitiatorId    string                                    `protobuf:"bytes,4,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	RequestId      string                                    `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Synchronous    bool                                      `protobuf:"varint,6,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+}
+
+func (x *QueryAccountBalanceRequest) Reset() {
+	*x = QueryAccountBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountBalanceRequest) ProtoMessage() {}
+
+func (x *QueryAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*QueryAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *QueryAccountBalanceRequest) GetIdentifierType() QueryAccountBalanceRequest_IdentifierType {
+	if x != nil {
+		return x.IdentifierType
+	}
+	return QueryAccountBalanceRequest_QUERY_ACCOUNT_UNSPECIFIED
+}
+
+func (x *QueryAccountBalanceRequest) GetPartyA() int64 {
+	if x != nil {
+		return x.PartyA
+	}
+	return 0
+}
+
+func (x *QueryAccountBalanceRequest) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *QueryAccountBalanceRequest) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *QueryAccountBalanceRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *QueryAccountBalanceRequest) GetSynchronous() bool {
+	if x != nil {
+		return x.Synchronous
+	}
+	return false
+}
+
+type QueryAccountBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Party               int64   `protobuf:"varint,1,opt,name=party,proto3" json:"party,omitempty"`
+	WorkingAccountFunds float32 `protobuf:"fixed32,2,opt,name=working_account_funds,json=workingAccountFunds,proto3" json:"working_account_funds,omitempty"`
+	UtilityAccountFunds float32 `protobuf:"fixed32,3,opt,name=utility_account_funds,json=utilityAccountFunds,proto3" json:"utility_account_funds,omitempty"`
+	ChargesPaidFunds    float32 `protobuf:"fixed32,4,opt,name=charges_paid_funds,json=chargesPaidFunds,proto3" json:"charges_paid_funds,omitempty"`
+	RequestId           string  `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	InitiatorId         string  `protobuf:"bytes,6,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	Completed           bool    `protobuf:"varint,7,opt,name=completed,proto3" json:"completed,omitempty"`
+}
+
+func (x *QueryAccountBalanceResponse) Reset() {
+	*x = QueryAccountBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAccountBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAccountBalanceResponse) ProtoMessage() {}
+
+func (x *QueryAccountBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAccountBalanceResponse.ProtoReflect.Descriptor instead.
+func (*QueryAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *QueryAccountBalanceResponse) GetParty() int64 {
+	if x != nil {
+		return x.Party
+	}
+	return 0
+}
+
+func (x *QueryAccountBalanceResponse) GetWorkingAccountFunds() float32 {
+	if x != nil {
+		return x.WorkingAccountFunds
+	}
+	return 0
+}
+
+func (x *QueryAccountBalanceResponse) GetUtilityAccountFunds() float32 {
+	if x != nil {
+		return x.UtilityAccountFunds
+	}
+	return 0
+}
+
+func (x *QueryAccountBalanceResponse) GetChargesPaidFunds() float32 {
+	if x != nil {
+		return x.ChargesPaidFunds
+	}
+	return 0
+}
+
+func (x *QueryAccountBalanceResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *QueryAccountBalanceResponse) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *QueryAccountBalanceResponse) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type ReverseTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiverType  int64  `protobuf:"varint,1,opt,name=receiver_type,json=receiverType,proto3" json:"receiver_type,omitempty"`
+	ShortCode     int32  `protobuf:"varint,2,opt,name=short_code,json=shortCode,proto3" json:"short_code,omitempty"`
+	Remarks       string `protobuf:"bytes,3,opt,name=remarks,proto3" json:"remarks,omitempty"`
+	TransactionId string `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Occassion     string `protobuf:"bytes,5,opt,name=occassion,proto3" json:"occassion,omitempty"`
+	RequestId     string `protobuf:"bytes,6,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	InitiatorId   string `protobuf:"bytes,7,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	Synchronous   bool   `protobuf:"varint,8,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+}
+
+func (x *ReverseTransactionRequest) Reset() {
+	*x = ReverseTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseTransactionRequest) ProtoMessage() {}
+
+func (x *ReverseTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ReverseTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReverseTransactionRequest) GetReceiverType() int64 {
+	if x != nil {
+		return x.ReceiverType
+	}
+	return 0
+}
+
+func (x *ReverseTransactionRequest) GetShortCode() int32 {
+	if x != nil {
+		return x.ShortCode
+	}
+	return 0
+}
+
+func (x *ReverseTransactionRequest) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetOccassion() string {
+	if x != nil {
+		return x.Occassion
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *ReverseTransactionRequest) GetSynchronous() bool {
+	if x != nil {
+		return x.Synchronous
+	}
+	return false
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId    string         `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	InitiatorId       string         `protobuf:"bytes,2,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	Url               string         `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Secret            string         `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	EventTypes        []B2CEventType `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=gidyon.mpesa.b2c.B2CEventType" json:"event_types,omitempty"`
+	Description       string         `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Active            bool           `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	CreateTimeSeconds int64          `protobuf:"varint,8,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+	UpdateTimeSeconds int64          `protobuf:"varint,9,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WebhookSubscription) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []B2CEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *WebhookSubscription) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *WebhookSubscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken    string   `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize     int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	InitiatorIds []string `protobuf:"bytes,3,rep,name=initiator_ids,json=initiatorIds,proto3" json:"initiator_ids,omitempty"`
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListWebhookSubscriptionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookSubscriptionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookSubscriptionsRequest) GetInitiatorIds() []string {
+	if x != nil {
+		return x.InitiatorIds
+	}
+	return nil
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string                 `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId             string                `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+	SubscriptionId         string                `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	InitiatorId            string                `protobuf:"bytes,3,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	TransactionId          uint64                `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	EventType              B2CEventType          `protobuf:"varint,5,opt,name=event_type,json=eventType,proto3,enum=gidyon.mpesa.b2c.B2CEventType" json:"event_type,omitempty"`
+	Url                    string                `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Payload                string                `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	Status                 WebhookDeliveryStatus `protobuf:"varint,8,opt,name=status,proto3,enum=gidyon.mpesa.b2c.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts               int32                 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode         int32                 `protobuf:"varint,10,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError              string                `protobuf:"bytes,11,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptTimeSeconds int64                 `protobuf:"varint,12,opt,name=next_attempt_time_seconds,json=nextAttemptTimeSeconds,proto3" json:"next_attempt_time_seconds,omitempty"`
+	DeliveredTimeSeconds   int64                 `protobuf:"varint,13,opt,name=delivered_time_seconds,json=deliveredTimeSeconds,proto3" json:"delivered_time_seconds,omitempty"`
+	CreateTimeSeconds      int64                 `protobuf:"varint,14,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+	UpdateTimeSeconds      int64                 `protobuf:"varint,15,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetTransactionId() uint64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventType() B2CEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return B2CEventType_B2C_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptTimeSeconds() int64 {
+	if x != nil {
+		return x.NextAttemptTimeSeconds
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetDeliveredTimeSeconds() int64 {
+	if x != nil {
+		return x.DeliveredTimeSeconds
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken       string                  `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize        int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SubscriptionIds []string                `protobuf:"bytes,3,rep,name=subscription_ids,json=subscriptionIds,proto3" json:"subscription_ids,omitempty"`
+	TransactionIds  []uint64                `protobuf:"varint,4,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	Statuses        []WebhookDeliveryStatus `protobuf:"varint,5,rep,packed,name=statuses,proto3,enum=gidyon.mpesa.b2c.WebhookDeliveryStatus" json:"statuses,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionIds() []string {
+	if x != nil {
+		return x.SubscriptionIds
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesRequest) GetTransactionIds() []uint64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatuses() []WebhookDeliveryStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string             `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId string `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type GetPublishStreamInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelName string `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+}
+
+func (x *GetPublishStreamInfoRequest) Reset() {
+	*x = GetPublishStreamInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublishStreamInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublishStreamInfoRequest) ProtoMessage() {}
+
+func (x *GetPublishStreamInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublishStreamInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPublishStreamInfoRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPublishStreamInfoRequest) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+type StreamConsumerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pending    int64  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	IdleMillis int64  `protobuf:"varint,3,opt,name=idle_millis,json=idleMillis,proto3" json:"idle_millis,omitempty"`
+}
+
+func (x *StreamConsumerInfo) Reset() {
+	*x = StreamConsumerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamConsumerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConsumerInfo) ProtoMessage() {}
+
+func (x *StreamConsumerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConsumerInfo.ProtoReflect.Descriptor instead.
+func (*StreamConsumerInfo) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StreamConsumerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamConsumerInfo) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *StreamConsumerInfo) GetIdleMillis() int64 {
+	if x != nil {
+		return x.IdleMillis
+	}
+	return 0
+}
+
+type StreamGroupInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pending         int64                 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Lag             int64                 `protobuf:"varint,3,opt,name=lag,proto3" json:"lag,omitempty"`
+	LastDeliveredId string                `protobuf:"bytes,4,opt,name=last_delivered_id,json=lastDeliveredId,proto3" json:"last_delivered_id,omitempty"`
+	Consumers       []*StreamConsumerInfo `protobuf:"bytes,5,rep,name=consumers,proto3" json:"consumers,omitempty"`
+}
+
+func (x *StreamGroupInfo) Reset() {
+	*x = StreamGroupInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamGroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGroupInfo) ProtoMessage() {}
+
+func (x *StreamGroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGroupInfo.ProtoReflect.Descriptor instead.
+func (*StreamGroupInfo) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StreamGroupInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StreamGroupInfo) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *StreamGroupInfo) GetLag() int64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *StreamGroupInfo) GetLastDeliveredId() string {
+	if x != nil {
+		return x.LastDeliveredId
+	}
+	return ""
+}
+
+func (x *StreamGroupInfo) GetConsumers() []*StreamConsumerInfo {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+type PublishStreamInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelName string             `protobuf:"bytes,1,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	StreamKey   string             `protobuf:"bytes,2,opt,name=stream_key,json=streamKey,proto3" json:"stream_key,omitempty"`
+	Length      int64              `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Groups      []*StreamGroupInfo `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *PublishStreamInfo) Reset() {
+	*x = PublishStreamInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishStreamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishStreamInfo) ProtoMessage() {}
+
+func (x *PublishStreamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishStreamInfo.ProtoReflect.Descriptor instead.
+func (*PublishStreamInfo) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PublishStreamInfo) GetChannelName() string {
+	if x != nil {
+		return x.ChannelName
+	}
+	return ""
+}
+
+func (x *PublishStreamInfo) GetStreamKey() string {
+	if x != nil {
+		return x.StreamKey
+	}
+	return ""
+}
+
+func (x *PublishStreamInfo) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *PublishStreamInfo) GetGroups() []*StreamGroupInfo {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ArchivePaymentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Overrides the configured retention; payments created this many months ago or earlier are archived
+	RetentionMonths int32 `protobuf:"varint,1,opt,name=retention_months,json=retentionMonths,proto3" json:"retention_months,omitempty"`
+}
+
+func (x *ArchivePaymentsRequest) Reset() {
+	*x = ArchivePaymentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivePaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePaymentsRequest) ProtoMessage() {}
+
+func (x *ArchivePaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ArchivePaymentsRequest) GetRetentionMonths() int32 {
+	if x != nil {
+		return x.RetentionMonths
+	}
+	return 0
+}
+
+type ArchivalRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId             string            `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Status            ArchivalRunStatus `protobuf:"varint,2,opt,name=status,proto3,enum=gidyon.mpesa.b2c.ArchivalRunStatus" json:"status,omitempty"`
+	Target            ArchiveTarget     `protobuf:"varint,3,opt,name=target,proto3,enum=gidyon.mpesa.b2c.ArchiveTarget" json:"target,omitempty"`
+	RetentionMonths   int32             `protobuf:"varint,4,opt,name=retention_months,json=retentionMonths,proto3" json:"retention_months,omitempty"`
+	CutoffTimeSeconds int64             `protobuf:"varint,5,opt,name=cutoff_time_seconds,json=cutoffTimeSeconds,proto3" json:"cutoff_time_seconds,omitempty"`
+	PaymentsArchived  int64             `protobuf:"varint,6,opt,name=payments_archived,json=paymentsArchived,proto3" json:"payments_archived,omitempty"`
+	// Archive file of the run when archiving to files
+	File              string `protobuf:"bytes,7,opt,name=file,proto3" json:"file,omitempty"`
+	Error             string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	RequestedBy       string `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	StartTimeSeconds  int64  `protobuf:"varint,10,opt,name=start_time_seconds,json=startTimeSeconds,proto3" json:"start_time_seconds,omitempty"`
+	EndTimeSeconds    int64  `protobuf:"varint,11,opt,name=end_time_seconds,json=endTimeSeconds,proto3" json:"end_time_seconds,omitempty"`
+	UpdateTimeSeconds int64  `protobuf:"varint,12,opt,name=update_time_seconds,json=updateTimeSeconds,proto3" json:"update_time_seconds,omitempty"`
+}
+
+func (x *ArchivalRun) Reset() {
+	*x = ArchivalRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchivalRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivalRun) ProtoMessage() {}
+
+func (x *ArchivalRun) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivalRun.ProtoReflect.Descriptor instead.
+func (*ArchivalRun) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ArchivalRun) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ArchivalRun) GetStatus() ArchivalRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ArchivalRunStatus_ARCHIVAL_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *ArchivalRun) GetTarget() ArchiveTarget {
+	if x != nil {
+		return x.Target
+	}
+	return ArchiveTarget_ARCHIVE_TARGET_UNSPECIFIED
+}
+
+func (x *ArchivalRun) GetRetentionMonths() int32 {
+	if x != nil {
+		return x.RetentionMonths
+	}
+	return 0
+}
+
+func (x *ArchivalRun) GetCutoffTimeSeconds() int64 {
+	if x != nil {
+		return x.CutoffTimeSeconds
+	}
+	return 0
+}
+
+func (x *ArchivalRun) GetPaymentsArchived() int64 {
+	if x != nil {
+		return x.PaymentsArchived
+	}
+	return 0
+}
+
+func (x *ArchivalRun) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *ArchivalRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ArchivalRun) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ArchivalRun) GetStartTimeSeconds() int64 {
+	if x != nil {
+		return x.StartTimeSeconds
+	}
+	return 0
+}
+
+func (x *ArchivalRun) GetEndTimeSeconds() int64 {
+	if x != nil {
+		return x.EndTimeSeconds
+	}
+	return 0
+}
+
+func (x *ArchivalRun) GetUpdateTimeSeconds() int64 {
+	if x != nil {
+		return x.UpdateTimeSeconds
+	}
+	return 0
+}
+
+type GetArchivalRunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+}
+
+func (x *GetArchivalRunRequest) Reset() {
+	*x = GetArchivalRunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArchivalRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchivalRunRequest) ProtoMessage() {}
+
+func (x *GetArchivalRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchivalRunRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivalRunRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetArchivalRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type ListArchivalRunsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken string              `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize  int32               `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Statuses  []ArchivalRunStatus `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=gidyon.mpesa.b2c.ArchivalRunStatus" json:"statuses,omitempty"`
+}
+
+func (x *ListArchivalRunsRequest) Reset() {
+	*x = ListArchivalRunsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArchivalRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivalRunsRequest) ProtoMessage() {}
+
+func (x *ListArchivalRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivalRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListArchivalRunsRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListArchivalRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListArchivalRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListArchivalRunsRequest) GetStatuses() []ArchivalRunStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+type ListArchivalRunsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string         `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Runs          []*ArchivalRun `protobuf:"bytes,2,rep,name=runs,proto3" json:"runs,omitempty"`
+}
+
+func (x *ListArchivalRunsResponse) Reset() {
+	*x = ListArchivalRunsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListArchivalRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListArchivalRunsResponse) ProtoMessage() {}
+
+func (x *ListArchivalRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListArchivalRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListArchivalRunsResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListArchivalRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListArchivalRunsResponse) GetRuns() []*ArchivalRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public part of the key; the secret is never stored
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Auth group the key acts as
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// RPCs the key may call e.g. TransferFunds, or * for all
+	Rpcs []string `protobuf:"bytes,4,rep,name=rpcs,proto3" json:"rpcs,omitempty"`
+	// Short codes the key may act for; empty allows any
+	ShortCodes []string `protobuf:"bytes,5,rep,name=short_codes,json=shortCodes,proto3" json:"short_codes,omitempty"`
+	// Initiator the key may act for; empty allows any
+	InitiatorId         string `protobuf:"bytes,6,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	CreatedBy           string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	Revoked             bool   `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	ExpireTimeSeconds   int64  `protobuf:"varint,9,opt,name=expire_time_seconds,json=expireTimeSeconds,proto3" json:"expire_time_seconds,omitempty"`
+	LastUsedTimeSeconds int64  `protobuf:"varint,10,opt,name=last_used_time_seconds,json=lastUsedTimeSeconds,proto3" json:"last_used_time_seconds,omitempty"`
+	RevokeTimeSeconds   int64  `protobuf:"varint,11,opt,name=revoke_time_seconds,json=revokeTimeSeconds,proto3" json:"revoke_time_seconds,omitempty"`
+	CreateTimeSeconds   int64  `protobuf:"varint,12,opt,name=create_time_seconds,json=createTimeSeconds,proto3" json:"create_time_seconds,omitempty"`
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *APIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *APIKey) GetRpcs() []string {
+	if x != nil {
+		return x.Rpcs
+	}
+	return nil
+}
+
+func (x *APIKey) GetShortCodes() []string {
+	if x != nil {
+		return x.ShortCodes
+	}
+	return nil
+}
+
+func (x *APIKey) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *APIKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *APIKey) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *APIKey) GetExpireTimeSeconds() int64 {
+	if x != nil {
+		return x.ExpireTimeSeconds
+	}
+	return 0
+}
+
+func (x *APIKey) GetLastUsedTimeSeconds() int64 {
+	if x != nil {
+		return x.LastUsedTimeSeconds
+	}
+	return 0
+}
+
+func (x *APIKey) GetRevokeTimeSeconds() int64 {
+	if x != nil {
+		return x.RevokeTimeSeconds
+	}
+	return 0
+}
+
+func (x *APIKey) GetCreateTimeSeconds() int64 {
+	if x != nil {
+		return x.CreateTimeSeconds
+	}
+	return 0
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CreateAPIKeyRequest) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *APIKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// Key to send as a bearer token; it cannot be retrieved again
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() *APIKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageToken      string   `protobuf:"bytes,1,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize       int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	InitiatorIds   []string `protobuf:"bytes,3,rep,name=initiator_ids,json=initiatorIds,proto3" json:"initiator_ids,omitempty"`
+	IncludeRevoked bool     `protobuf:"varint,4,opt,name=include_revoked,json=includeRevoked,proto3" json:"include_revoked,omitempty"`
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAPIKeysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAPIKeysRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAPIKeysRequest) GetInitiatorIds() []string {
+	if x != nil {
+		return x.InitiatorIds
+	}
+	return nil
+}
+
+func (x *ListAPIKeysRequest) GetIncludeRevoked() bool {
+	if x != nil {
+		return x.IncludeRevoked
+	}
+	return false
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextPageToken string    `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ApiKeys       []*APIKey `protobuf:"bytes,2,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAPIKeysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_b2c_v1_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_b2c_v1_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_b2c_v1_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+var File_b2c_v1_proto protoreflect.FileDescriptor
+
+var file_b2c_v1_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x62, 0x32, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x75, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x9c, 0x05, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x40, 0x0a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x1c, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x22,
+	0x0a, 0x09, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x49, 0x64, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x46, 0x0a, 0x1f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x54, 0x92, 0x41, 0x51, 0x0a, 0x4f, 0x2a,
+	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x37, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x20, 0x66, 0x75, 0x6e, 0x64, 0x73,
+	0x20, 0x62, 0x32, 0x63, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x62, 0x75, 0x73, 0x69, 0x6e, 0x65,
+	0x73, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x22, 0x91,
+	0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x42,
+	0x92, 0x41, 0x3f, 0x0a, 0x3d, 0x2a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46,
+	0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x24, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x90, 0x03, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x73, 0x69,
+	0x73, 0x64, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73, 0x69, 0x73, 0x64,
+	0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x39, 0x92, 0x41, 0x36, 0x0a,
+	0x34, 0x2a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x32, 0x22, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x20, 0x73, 0x74, 0x6b,
+	0x20, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xdd, 0x02, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x6f, 0x6e, 0x6c, 0x79, 0x4f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x44, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x09, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x42, 0x32, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa0, 0x0b, 0x0a, 0x0a, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x40,
+	0x0a, 0x1c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72,
+	0x67, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49,
+	0x64, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x73,
+	0x69, 0x73, 0x64, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x38, 0x0a, 0x18, 0x62, 0x32, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x16, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x32, 0x63,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x32, 0x63, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62,
+	0x32, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x32, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x50, 0x61, 0x72, 0x74, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x75,
+	0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e,
+	0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x67, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x14, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x62, 0x32, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x09, 0x62, 0x32, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x65, 0x64, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x1f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x1d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x3b, 0x0a,
+	0x1a, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x17, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x3a, 0x2c, 0x92, 0x41, 0x29, 0x0a,
+	0x27, 0x2a, 0x0a, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x32, 0x19, 0x4d,
+	0x70, 0x65, 0x73, 0x61, 0x20, 0x42, 0x32, 0x43, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4d,
+	0x70, 0x65, 0x73, 0x61, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x37, 0x0a, 0x09,
+	0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x3a, 0x4b, 0x92, 0x41, 0x48, 0x0a, 0x46, 0x2a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x21, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x76, 0x65, 0x20, 0x61, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0xd2, 0x01, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xfa, 0x08,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x1d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x20, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x1e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x62, 0x32, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32,
+	0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b, 0x62, 0x32, 0x63, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42,
+	0x32, 0x43, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x4c,
+	0x0a, 0x0f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x73, 0x12, 0x4c, 0x0a, 0x0f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x3b,
+	0x0a, 0x1a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74,
+	0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x3d, 0x92, 0x41, 0x3a,
+	0x0a, 0x38, 0x2a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x32, 0x20, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x69, 0x6e, 0x67, 0x20, 0x62, 0x32,
+	0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd4, 0x02, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x34, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x69, 0x65,
+	0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6d, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x61, 0x73, 0x6b,
+	0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x32, 0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x32, 0x63,
+	0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xfe, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x62, 0x32, 0x63, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42,
+	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x62, 0x32, 0x63, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x4f, 0x92, 0x41, 0x4c, 0x0a, 0x4a, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32,
+	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x2f, 0x52, 0x65, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xb2, 0x02, 0x0a, 0x18, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x3d, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x42, 0x79, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x3d,
+	0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x3a, 0x42, 0x92, 0x41, 0x3f, 0x0a, 0x3d, 0x2a, 0x18, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x21, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f,
+	0x20, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xd9, 0x04, 0x0a, 0x10, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x6f, 0x72, 0x67, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x62, 0x32, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x09, 0x62, 0x32, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x73, 0x69, 0x73, 0x64, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x73, 0x69, 0x73, 0x64, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x70, 0x65, 0x73,
+	0x61, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x46, 0x92, 0x41, 0x43,
+	0x0a, 0x41, 0x2a, 0x10, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x32, 0x2d, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64,
+	0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x20, 0x6f, 0x66, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x19, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x3a, 0x4d, 0x92, 0x41, 0x4a, 0x0a, 0x48, 0x2a, 0x19, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x2b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32, 0x43,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x36, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x3a, 0x49, 0x92, 0x41, 0x46, 0x0a, 0x44, 0x2a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x28, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xb3, 0x01, 0x0a,
+	0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x45, 0x92, 0x41, 0x42,
+	0x0a, 0x40, 0x2a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x32, 0x26, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x66, 0x69,
+	0x6c, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x32, 0x43, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x3a, 0x45, 0x92, 0x41, 0x42, 0x0a, 0x40, 0x2a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42,
+	0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x25, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x18, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x3a, 0x56, 0x92, 0x41, 0x53, 0x0a, 0x51, 0x32, 0x35, 0x42, 0x32, 0x43, 0x20, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68,
+	0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x73, 0x75, 0x6d,
+	0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2a, 0x18,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xad, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52,
+	0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x3a, 0x4e, 0x92, 0x41, 0x4b, 0x0a, 0x49, 0x32,
+	0x2d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x20, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0x18,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfc, 0x01, 0x0a, 0x18, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x23, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x3a, 0x41, 0x92, 0x41, 0x3e, 0x0a, 0x3c, 0x32, 0x20, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x20,
+	0x61, 0x20, 0x62, 0x32, 0x63, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2a, 0x18, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xfc, 0x06, 0x0a, 0x09, 0x44, 0x61, 0x69, 0x6c,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53,
+	0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2f, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x5f, 0x63, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x4d, 0x70, 0x65, 0x73, 0x61, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31,
+	0x0a, 0x14, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x44, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x1f, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x1c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x3d,
+	0x0a, 0x1b, 0x70, 0x39, 0x35, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x18, 0x70, 0x39, 0x35, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x3a, 0x37, 0x92,
+	0x41, 0x34, 0x0a, 0x32, 0x32, 0x25, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x64, 0x61, 0x79, 0x20, 0x62, 0x32, 0x63, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x09, 0x44, 0x61, 0x69,
+	0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x49, 0x64, 0x53, 0x74, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x65, 0x64, 0x3a, 0x45, 0x92, 0x41, 0x42, 0x0a, 0x40, 0x2a, 0x0d, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x53, 0x74, 0x61, 0x74, 0x32, 0x2f, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x62, 0x32, 0x63, 0x20, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61,
+	0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x69, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x0d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x3d, 0x92, 0x41, 0x3a, 0x0a, 0x38, 0x32,
+	0x27, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfe, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x16, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x78, 0x44, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x39, 0x2a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x32, 0x26, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x20, 0x63, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69,
+	0x61, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x3a, 0x37, 0x92, 0x41, 0x34, 0x0a, 0x32,
+	0x2a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x22, 0x8e, 0x04, 0x0a, 0x0a, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x24,
+	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x17, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
+	0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a,
+	0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x29, 0x92, 0x41, 0x26, 0x0a, 0x24, 0x32,
+	0x16, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x61, 0x6e, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x2a, 0x0a, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x22, 0xd5, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72,
+	0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x3a, 0x44, 0x92, 0x41, 0x41, 0x0a, 0x3f, 0x2a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x32, 0x25, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f,
+	0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x3a, 0x45, 0x92, 0x41, 0x42, 0x0a, 0x40, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74,
+	0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0x25, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xb2, 0x04, 0x0a, 0x0b, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x72, 0x67,
+	0x5f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x2d, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37,
+	0x0a, 0x17, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x3a, 0x4a, 0x92, 0x41, 0x47, 0x0a, 0x45, 0x2a, 0x0b, 0x4d, 0x6f, 0x6e, 0x74,
+	0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x32, 0x36, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x20,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x20, 0x75, 0x70, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22,
+	0xd8, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x3a, 0x46, 0x92, 0x41, 0x43, 0x0a, 0x41, 0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x26, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x47, 0x92, 0x41, 0x44, 0x0a, 0x42, 0x2a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x26, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x6f, 0x6e, 0x74, 0x68,
+	0x6c, 0x79, 0x20, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x89, 0x02,
+	0x0a, 0x16, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41,
+	0x01, 0x02, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x3a, 0x67, 0x92, 0x41, 0x64, 0x0a, 0x62, 0xd2, 0x01, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0xd2, 0x01, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x2a,
+	0x16, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x30, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xcd, 0x04, 0x0a, 0x08, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73,
+	0x6b, 0x69, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x61, 0x79, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x61, 0x79, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x3a, 0x47, 0x92, 0x41, 0x44, 0x0a, 0x42, 0x2a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f,
+	0x62, 0x32, 0x36, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x20, 0x6a, 0x6f,
+	0x62, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x3a, 0x55, 0x92,
+	0x41, 0x52, 0x0a, 0x50, 0x2a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x20, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6a, 0x6f, 0x62, 0xd2, 0x01, 0x06, 0x6a, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x22, 0x8e, 0x05, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6d, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x3e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x41, 0x12, 0x1e, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x55, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6f,
+	0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e,
+	0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73, 0x22, 0x6d, 0x0a, 0x0e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a,
+	0x1d, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x53, 0x49, 0x53, 0x44, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b,
+	0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a,
+	0x17, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x3a, 0x49, 0x92, 0x41, 0x46, 0x0a,
+	0x44, 0x2a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x32, 0x23, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x3a, 0x2a, 0x92, 0x41, 0x27, 0x0a, 0x25, 0x2a, 0x0d, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x14, 0x4d, 0x70, 0x65, 0x73, 0x61,
+	0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xe5, 0x03, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x6a,
+	0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3b, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x61,
+	0x72, 0x74, 0x79, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01,
+	0x02, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x12, 0x1e, 0x0a, 0x07, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0c, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e,
+	0x6f, 0x75, 0x73, 0x22, 0x69, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x53, 0x49, 0x53, 0x44, 0x4e, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x49, 0x4c, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x3a, 0x47,
+	0x92, 0x41, 0x44, 0x0a, 0x42, 0x2a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x32, 0x24, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xf7, 0x02, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x32, 0x0a,
+	0x15, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64,
+	0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x13, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73,
+	0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x10, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x73, 0x50, 0x61, 0x69, 0x64, 0x46, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x3a, 0x4c, 0x92, 0x41, 0x49, 0x0a, 0x47, 0x2a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x28, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x20, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x88, 0x03, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x68,
+	0x6f, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04,
+	0xe2, 0x41, 0x01, 0x02, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1e, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12,
+	0x2b, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0c, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x79, 0x6e, 0x63, 0x68, 0x72, 0x6f,
+	0x6e, 0x6f, 0x75, 0x73, 0x3a, 0x46, 0x92, 0x41, 0x43, 0x0a, 0x41, 0x32, 0x24, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x20,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc7, 0x03, 0x0a,
+	0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a,
+	0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42,
+	0x32, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x3a, 0x53, 0x92, 0x41, 0x50, 0x0a, 0x4e, 0x2a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x37, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e,
+	0x67, 0x20, 0x62, 0x32, 0x63, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x76, 0x65,
+	0x72, 0x20, 0x68, 0x74, 0x74, 0x70, 0x22, 0xd5, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x60, 0x92, 0x41,
+	0x5d, 0x0a, 0x5b, 0x32, 0x28, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0x01, 0x0c,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x20, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe5,
+	0x01, 0x0a, 0x1f, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x73, 0x3a, 0x61, 0x92, 0x41, 0x5e, 0x0a, 0x5c, 0x2a, 0x1f, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x39, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65,
+	0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66,
+	0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x4b, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x3a, 0x62, 0x92, 0x41, 0x5f, 0x0a, 0x5d, 0x32, 0x39, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2a, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x3a, 0x63, 0x92, 0x41, 0x60, 0x0a, 0x5e, 0xd2,
+	0x01, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x2a, 0x20, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x32, 0x28, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x20, 0x61, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x20, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x05,
+	0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x3f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
+	0x62, 0x32, 0x63, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x3e, 0x92, 0x41, 0x3b, 0x0a, 0x39, 0x2a, 0x0f,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x32,
+	0x26, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x20, 0x6c, 0x6f, 0x67, 0x20, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x61, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x20, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xd0, 0x02, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x3a, 0x5b, 0x92,
+	0x41, 0x58, 0x0a, 0x56, 0x2a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x32, 0x36, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x41, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x5c, 0x92, 0x41, 0x59, 0x0a, 0x57, 0x32, 0x36,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x20, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0a, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x3a, 0x56, 0x92, 0x41, 0x53, 0x0a, 0x51, 0xd2,
+	0x01, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x2a, 0x17, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x28, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x74, 0x6f, 0x20, 0x73, 0x65, 0x6e, 0x64, 0x20, 0x61, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x20, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x20, 0x61, 0x67, 0x61, 0x69, 0x6e,
+	0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x27, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x0b, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x61, 0x92, 0x41, 0x5e, 0x0a, 0x5c,
+	0xd2, 0x01, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x2a,
+	0x1b, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
+	0x65, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x20, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x12,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x64, 0x6c, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6c, 0x61, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x49,
+	0x64, 0x12, 0x42, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x3a, 0x57, 0x92, 0x41, 0x54, 0x0a, 0x52, 0x2a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x32, 0x3d, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x72, 0x20, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x20, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x98, 0x01, 0x0a, 0x16, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x3a,
+	0x53, 0x92, 0x41, 0x50, 0x0a, 0x4e, 0x2a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x34,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x04, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61,
+	0x6c, 0x52, 0x75, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x72, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x75, 0x74, 0x6f, 0x66,
+	0x66, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x45,
+	0x92, 0x41, 0x42, 0x0a, 0x40, 0x32, 0x31, 0x52, 0x75, 0x6e, 0x20, 0x6d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x20, 0x6f, 0x6c, 0x64, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x6f,
+	0x75, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x20, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x61, 0x6c, 0x52, 0x75, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x3a, 0x52, 0x92, 0x41,
+	0x4f, 0x0a, 0x4d, 0xd2, 0x01, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x2a, 0x15, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x32, 0x2b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20,
+	0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x75, 0x6e,
+	0x22, 0xf2, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61,
+	0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x3a, 0x5a, 0x92, 0x41, 0x57, 0x0a, 0x55,
+	0x2a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x3a, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61,
+	0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c,
+	0x20, 0x72, 0x75, 0x6e, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x75,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x3a, 0x5b, 0x92,
+	0x41, 0x58, 0x0a, 0x56, 0x2a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x3a,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x20, 0x6f, 0x66, 0x20, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x61, 0x6c, 0x20, 0x72, 0x75, 0x6e, 0x73, 0x22, 0xed, 0x03, 0x0a, 0x06, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x04,
+	0x72, 0x70, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x02,
+	0x52, 0x04, 0x72, 0x70, 0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x40, 0x92, 0x41, 0x3d, 0x0a, 0x3b, 0x2a,
+	0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x32, 0x31, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79,
+	0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x61, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x20, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x69, 0x6e, 0x73, 0x74, 0x65, 0x61,
+	0x64, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x4a, 0x57, 0x54, 0x22, 0x92, 0x01, 0x0a, 0x13, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x3a, 0x42, 0x92, 0x41, 0x3f,
+	0x0a, 0x3d, 0x2a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x74, 0x6f, 0x20, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50,
+	0x49, 0x20, 0x6b, 0x65, 0x79, 0xd2, 0x01, 0x07, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x22,
+	0x9b, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x3e, 0x92,
+	0x41, 0x3b, 0x0a, 0x39, 0x2a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x21, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20,
+	0x61, 0x20, 0x6e, 0x65, 0x77, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x22, 0xe7, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x3a, 0x47,
+	0x92, 0x41, 0x44, 0x0a, 0x42, 0x2a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x2c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x20, 0x61,
+	0x20, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x41,
+	0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x50, 0x49,
+	0x4b, 0x65, 0x79, 0x52, 0x07, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x48, 0x92, 0x41,
+	0x45, 0x0a, 0x43, 0x2a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x2c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x20, 0x61, 0x20,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x6f, 0x66, 0x20, 0x41, 0x50,
+	0x49, 0x20, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x75, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
+	0x41, 0x01, 0x02, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x3a, 0x41, 0x92, 0x41, 0x3e, 0x0a,
+	0x3c, 0xd2, 0x01, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x2a, 0x13, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32,
+	0x1c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x20, 0x61, 0x6e, 0x20, 0x41, 0x50, 0x49, 0x20, 0x6b, 0x65, 0x79, 0x2a, 0x67, 0x0a,
+	0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x4f,
+	0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x41, 0x4c, 0x41, 0x52, 0x59, 0x5f,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x42, 0x55, 0x53,
+	0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x2a, 0x71, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x55,
+	0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a,
+	0x18, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x50, 0x55, 0x42, 0x53, 0x55, 0x42, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50,
+	0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x02, 0x2a, 0xb5, 0x01, 0x0a, 0x09, 0x42, 0x32,
+	0x43, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x32, 0x43, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x42, 0x32, 0x43, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x32, 0x43, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x32, 0x43, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x32, 0x43, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44,
+	0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f,
+	0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x42, 0x32, 0x43, 0x5f, 0x52, 0x45, 0x56,
+	0x45, 0x52, 0x53, 0x45, 0x44, 0x10, 0x06, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x32, 0x43, 0x5f, 0x52,
+	0x45, 0x56, 0x45, 0x52, 0x53, 0x41, 0x4c, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x07, 0x2a, 0x65, 0x0a, 0x0e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x56,
+	0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x32, 0x43, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x43, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x42, 0x32, 0x43, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x49, 0x4e,
+	0x49, 0x4d, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x42,
+	0x32, 0x43, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x5f, 0x4f, 0x4e, 0x4c,
+	0x59, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x10, 0x02, 0x2a, 0x73, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x32, 0x43,
+	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x32,
+	0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x1d,
+	0x0a, 0x19, 0x42, 0x32, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x42, 0x32, 0x43, 0x5f, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x03, 0x2a, 0x5f, 0x0a,
+	0x11, 0x42, 0x32, 0x43, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x32, 0x43, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x32, 0x43, 0x5f, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42,
+	0x32, 0x43, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x62,
+	0x0a, 0x11, 0x42, 0x32, 0x43, 0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x32, 0x43, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x5f,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x42,
+	0x32, 0x43, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x10, 0x02, 0x2a, 0x78, 0x0a, 0x11, 0x42, 0x32, 0x43, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x32, 0x43, 0x5f, 0x52,
+	0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x42, 0x32, 0x43, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45,
+	0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x32,
+	0x43, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x60, 0x0a, 0x11,
+	0x42, 0x32, 0x43, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x52, 0x4f, 0x43,
+	0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x32, 0x43, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xdc,
+	0x01, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x42, 0x79, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45,
+	0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x47, 0x47, 0x52, 0x45,
+	0x47, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x48,
+	0x4f, 0x52, 0x54, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x47,
+	0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x42, 0x59,
+	0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d,
+	0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f,
+	0x42, 0x59, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x49, 0x44, 0x10, 0x03, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f,
+	0x55, 0x50, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x04, 0x12, 0x1d,
+	0x0a, 0x19, 0x41, 0x47, 0x47, 0x52, 0x45, 0x47, 0x41, 0x54, 0x45, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x5f, 0x42, 0x59, 0x5f, 0x4d, 0x53, 0x49, 0x53, 0x44, 0x4e, 0x10, 0x05, 0x2a, 0x81, 0x01,
+	0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x17,
+	0x54, 0x49, 0x4d, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x44,
+	0x41, 0x59, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x42, 0x55, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10,
+	0x04, 0x2a, 0x5c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x02, 0x2a,
+	0x8f, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4a, 0x4f,
+	0x42, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x4a, 0x4f, 0x42, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x04, 0x2a, 0xeb, 0x01, 0x0a, 0x0c, 0x42, 0x32, 0x43, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x32, 0x43, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1d, 0x0a, 0x19, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12,
+	0x19, 0x0a, 0x15, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x32,
+	0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x32, 0x43, 0x5f, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x07, 0x2a,
+	0x9b, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x45, 0x42,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45,
+	0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x87, 0x01,
+	0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x41, 0x4c, 0x5f,
+	0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x52, 0x43, 0x48,
+	0x49, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x52,
+	0x55, 0x4e, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x55, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x52, 0x43, 0x48,
+	0x49, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x52, 0x43, 0x48,
+	0x49, 0x56, 0x45, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x10, 0x02, 0x32, 0xa6,
+	0x1e, 0x0a, 0x05, 0x42, 0x32, 0x43, 0x56, 0x31, 0x12, 0x82, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x62,
+	0x32, 0x63, 0x2f, 0x76, 0x31, 0x2f, 0x7b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x09, 0x12, 0x07, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x12, 0x90, 0x01, 0x0a, 0x10,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
+	0x62, 0x32, 0x63, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
+	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x77, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01, 0x12, 0x91,
+	0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32,
+	0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
+	0x62, 0x32, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x30, 0x01, 0x12, 0x7d, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x42, 0x32, 0x43,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0x7d, 0x0a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x42, 0x32, 0x43, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x22, 0x19, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x42, 0x32, 0x43, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x01, 0x2a,
+	0x12, 0x7d, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69,
+	0x73, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12,
+	0x8a, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65,
+	0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c,
+	0x22, 0x17, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x48, 0x6f,
+	0x75, 0x72, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x8e, 0x01, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x22, 0x18, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a,
+	0x0f, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x28, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
+	0x62, 0x32, 0x63, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01,
+	0x2a, 0x22, 0x17, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x72, 0x65, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x22, 0x1e, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a,
+	0x67, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4a, 0x6f, 0x62, 0x12, 0x92, 0x01, 0x0a, 0x11,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61,
+	0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x22, 0x19, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x95, 0x01, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x69,
+	0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x1e, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9a, 0x01, 0x0a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x2c, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
+	0x62, 0x32, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76,
+	0x31, 0x3a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x62, 0x32, 0x63, 0x2f,
+	0x76, 0x31, 0x3a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xa4, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x69, 0x64,
+	0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x62,
+	0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0xae, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62,
+	0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x62, 0x32,
+	0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x95, 0x01, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa2, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x2e, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x62, 0x32,
+	0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x85, 0x01,
+	0x0a, 0x10, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x29, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73,
+	0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x62, 0x32,
+	0x63, 0x2f, 0x76, 0x31, 0x3a, 0x72, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x93, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x62, 0x32, 0x63,
+	0x2f, 0x76, 0x31, 0x3a, 0x67, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0f, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
+	0x17, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x12, 0x27, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e,
+	0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x61, 0x6c, 0x52, 0x75, 0x6e, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a,
+	0x22, 0x16, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x67, 0x65, 0x74, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x12, 0x8e, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x29, 0x2e,
+	0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x62,
+	0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7e, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79,
+	0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e,
+	0x62, 0x32, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
+	0x22, 0x14, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f,
+	0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x50, 0x49, 0x4b, 0x65,
+	0x79, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x2e, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d,
+	0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x41,
+	0x50, 0x49, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67,
+	0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2e, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x2e, 0x62, 0x32, 0x63, 0x2e,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01,
+	0x2a, 0x22, 0x14, 0x2f, 0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3a, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x42, 0xc7, 0x03, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e, 0x2f, 0x6d, 0x70,
+	0x65, 0x73, 0x61, 0x2d, 0x62, 0x32, 0x63, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x62, 0x32, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x32, 0x63, 0x5f, 0x76, 0x31, 0x92, 0x41, 0x90,
+	0x03, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x59, 0x0a, 0x57, 0x0a, 0x06, 0x62,
+	0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x4d, 0x08, 0x02, 0x20, 0x02, 0x1a, 0x0d, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x2c, 0x20, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x42, 0x65,
+	0x61, 0x72, 0x65, 0x72, 0x3a, 0x20, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x3c, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x3e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x62, 0x65, 0x61, 0x72, 0x65, 0x72,
+	0x12, 0x00, 0x12, 0xfc, 0x01, 0x0a, 0x11, 0x42, 0x32, 0x43, 0x20, 0x4d, 0x70, 0x65, 0x73, 0x61,
+	0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x11, 0x42, 0x32, 0x43, 0x20, 0x41, 0x50,
+	0x69, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x32, 0x02, 0x76, 0x31, 0x22,
+	0x76, 0x12, 0x46, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x62, 0x69, 0x74, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x69, 0x64, 0x65, 0x6f, 0x6e, 0x6b,
+	0x61, 0x6d, 0x61, 0x75, 0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x62, 0x32, 0x63, 0x2f, 0x6d, 0x61,
+	0x69, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x32, 0x63,
+	0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x67, 0x69, 0x64, 0x65, 0x6f,
+	0x6e, 0x68, 0x61, 0x63, 0x65, 0x72, 0x40, 0x67, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x0a, 0x15, 0x47, 0x69, 0x74, 0x68, 0x75, 0x62, 0x20, 0x3c, 0x47, 0x69, 0x64, 0x65, 0x6f, 0x6e,
+	0x20, 0x4b, 0x61, 0x6d, 0x61, 0x75, 0x3e, 0x2a, 0x58, 0x0a, 0x1a, 0x47, 0x4e, 0x55, 0x20, 0x47,
+	0x45, 0x4e, 0x45, 0x52, 0x41, 0x4c, 0x20, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x20, 0x4c, 0x49,
+	0x43, 0x45, 0x4e, 0x53, 0x45, 0x12, 0x3a, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x69, 0x64, 0x79, 0x6f, 0x6e,
+	0x2f, 0x6d, 0x70, 0x65, 0x73, 0x61, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x73,
+	0x72, 0x63, 0x2f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53,
+	0x45, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_b2c_v1_proto_rawDescOnce sync.Once
+	file_b2c_v1_proto_rawDescData = file_b2c_v1_proto_rawDesc
+)
+
+func file_b2c_v1_proto_rawDescGZIP() []byte {
+	file_b2c_v1_proto_rawDescOnce.Do(func() {
+		file_b2c_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_b2c_v1_proto_rawDescData)
+	})
+	return file_b2c_v1_proto_rawDescData
+}
+
+var file_b2c_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
+var file_b2c_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_b2c_v1_proto_goTypes = []interface{}{
+	(CommandId)(0),             // 0: gidyon.mpesa.b2c.CommandId
+	(PublishTransport)(0),      // 1: gidyon.mpesa.b2c.PublishTransport
+	(B2CStatus)(0),             // 2: gidyon.mpesa.b2c.B2CStatus
+	(B2CPaymentView)(0),        // 3: gidyon.mpesa.b2c.B2CPaymentView
+	(B2COrderField)(0),         // 4: gidyon.mpesa.b2c.B2COrderField
+	(B2COrderDirection)(0),     // 5: gidyon.mpesa.b2c.B2COrderDirection
+	(B2CSucceededState)(0),     // 6: gidyon.mpesa.b2c.B2CSucceededState
+	(B2CRecipientState)(0),     // 7: gidyon.mpesa.b2c.B2CRecipientState
+	(B2CProcessedState)(0),     // 8: gidyon.mpesa.b2c.B2CProcessedState
+	(AggregateGroupBy)(0),      // 9: gidyon.mpesa.b2c.AggregateGroupBy
+	(TimeBucket)(0),            // 10: gidyon.mpesa.b2c.TimeBucket
+	(ExportFormat)(0),          // 11: gidyon.mpesa.b2c.ExportFormat
+	(StatsJobStatus)(0),        // 12: gidyon.mpesa.b2c.StatsJobStatus
+	(B2CEventType)(0),          // 13: gidyon.mpesa.b2c.B2CEventType
+	(WebhookDeliveryStatus)(0), // 14: gidyon.mpesa.b2c.WebhookDeliveryStatus
+	(ArchivalRunStatus)(0),     // 15: gidyon.mpesa.b2c.ArchivalRunStatus
+	(ArchiveTarget)(0),         // 16: gidyon.mpesa.b2c.ArchiveTarget
+	(QueryTransactionStatusRequest_IdentifierType)(0), // 17: gidyon.mpesa.b2c.QueryTransactionStatusRequest.IdentifierType
+	(QueryAccountBalanceRequest_IdentifierType)(0),    // 18: gidyon.mpesa.b2c.QueryAccountBalanceRequest.IdentifierType
+	(*TransferFundsRequest)(nil),                      // 19: gidyon.mpesa.b2c.TransferFundsRequest
+	(*TransferFundsResponse)(nil),                     // 20: gidyon.mpesa.b2c.TransferFundsResponse
+	(*PublishMessage)(nil),                            // 21: gidyon.mpesa.b2c.PublishMessage
+	(*PublishInfo)(nil),                               // 22: gidyon.mpesa.b2c.PublishInfo
+	(*B2CPayment)(nil),                                // 23: gidyon.mpesa.b2c.B2CPayment
+	(*GetB2CPaymentRequest)(nil),                      // 24: gidyon.mpesa.b2c.GetB2CPaymentRequest
+	(*ListB2CPaymentFilter)(nil),                      // 25: gidyon.mpesa.b2c.ListB2CPaymentFilter
+	(*ListB2CPaymentsRequest)(nil),                    // 26: gidyon.mpesa.b2c.ListB2CPaymentsRequest
+	(*ListB2CPaymentsResponse)(nil),                   // 27: gidyon.mpesa.b2c.ListB2CPaymentsResponse
+	(*AggregatePaymentsRequest)(nil),                  // 28: gidyon.mpesa.b2c.AggregatePaymentsRequest
+	(*PaymentAggregate)(nil),                          // 29: gidyon.mpesa.b2c.PaymentAggregate
+	(*AggregatePaymentsResponse)(nil),                 // 30: gidyon.mpesa.b2c.AggregatePaymentsResponse
+	(*ExportB2CPaymentsRequest)(nil),                  // 31: gidyon.mpesa.b2c.ExportB2CPaymentsRequest
+	(*ExportB2CPaymentsChunk)(nil),                    // 32: gidyon.mpesa.b2c.ExportB2CPaymentsChunk
+	(*WatchB2CPaymentsRequest)(nil),                   // 33: gidyon.mpesa.b2c.WatchB2CPaymentsRequest
+	(*WatchB2CPaymentsResponse)(nil),                  // 34: gidyon.mpesa.b2c.WatchB2CPaymentsResponse
+	(*ProcessB2CPaymentRequest)(nil),                  // 35: gidyon.mpesa.b2c.ProcessB2CPaymentRequest
+	(*PublishB2CPaymentRequest)(nil),                  // 36: gidyon.mpesa.b2c.PublishB2CPaymentRequest
+	(*DailyStat)(nil),                                 // 37: gidyon.mpesa.b2c.DailyStat
+	(*CommandIdStat)(nil),                             // 38: gidyon.mpesa.b2c.CommandIdStat
+	(*StatsResponse)(nil),                             // 39: gidyon.mpesa.b2c.StatsResponse
+	(*ListStatsFilter)(nil),                           // 40: gidyon.mpesa.b2c.ListStatsFilter
+	(*ListDailyStatsRequest)(nil),                     // 41: gidyon.mpesa.b2c.ListDailyStatsRequest
+	(*HourlyStat)(nil),                                // 42: gidyon.mpesa.b2c.HourlyStat
+	(*ListHourlyStatsRequest)(nil),                    // 43: gidyon.mpesa.b2c.ListHourlyStatsRequest
+	(*ListHourlyStatsResponse)(nil),                   // 44: gidyon.mpesa.b2c.ListHourlyStatsResponse
+	(*MonthlyStat)(nil),                               // 45: gidyon.mpesa.b2c.MonthlyStat
+	(*ListMonthlyStatsRequest)(nil),                   // 46: gidyon.mpesa.b2c.ListMonthlyStatsRequest
+	(*ListMonthlyStatsResponse)(nil),                  // 47: gidyon.mpesa.b2c.ListMonthlyStatsResponse
+	(*RegenerateStatsRequest)(nil),                    // 48: gidyon.mpesa.b2c.RegenerateStatsRequest
+	(*StatsJob)(nil),                                  // 49: gidyon.mpesa.b2c.StatsJob
+	(*GetStatsJobRequest)(nil),                        // 50: gidyon.mpesa.b2c.GetStatsJobRequest
+	(*QueryTransactionStatusRequest)(nil),             // 51: gidyon.mpesa.b2c.QueryTransactionStatusRequest
+	(*QueryResponse)(nil),                             // 52: gidyon.mpesa.b2c.QueryResponse
+	(*QueryAccountBalanceRequest)(nil),                // 53: gidyon.mpesa.b2c.QueryAccountBalanceRequest
+	(*QueryAccountBalanceResponse)(nil),               // 54: gidyon.mpesa.b2c.QueryAccountBalanceResponse
+	(*ReverseTransactionRequest)(nil),                 // 55: gidyon.mpesa.b2c.ReverseTransactionRequest
+	(*WebhookSubscription)(nil),                       // 56: gidyon.mpesa.b2c.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),          // 57: gidyon.mpesa.b2c.CreateWebhookSubscriptionRequest
+	(*ListWebhookSubscriptionsRequest)(nil),           // 58: gidyon.mpesa.b2c.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),          // 59: gidyon.mpesa.b2c.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),          // 60: gidyon.mpesa.b2c.DeleteWebhookSubscriptionRequest
+	(*WebhookDelivery)(nil),                           // 61: gidyon.mpesa.b2c.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),              // 62: gidyon.mpesa.b2c.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),             // 63: gidyon.mpesa.b2c.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),                   // 64: gidyon.mpesa.b2c.RedeliverWebhookRequest
+	(*GetPublishStreamInfoRequest)(nil),               // 65: gidyon.mpesa.b2c.GetPublishStreamInfoRequest
+	(*StreamConsumerInfo)(nil),                        // 66: gidyon.mpesa.b2c.StreamConsumerInfo
+	(*StreamGroupInfo)(nil),                           // 67: gidyon.mpesa.b2c.StreamGroupInfo
+	(*PublishStreamInfo)(nil),                         // 68: gidyon.mpesa.b2c.PublishStreamInfo
+	(*ArchivePaymentsRequest)(nil),                    // 69: gidyon.mpesa.b2c.ArchivePaymentsRequest
+	(*ArchivalRun)(nil),                               // 70: gidyon.mpesa.b2c.ArchivalRun
+	(*GetArchivalRunRequest)(nil),                     // 71: gidyon.mpesa.b2c.GetArchivalRunRequest
+	(*ListArchivalRunsRequest)(nil),                   // 72: gidyon.mpesa.b2c.ListArchivalRunsRequest
+	(*ListArchivalRunsResponse)(nil),                  // 73: gidyon.mpesa.b2c.ListArchivalRunsResponse
+	(*APIKey)(nil),                                    // 74: gidyon.mpesa.b2c.APIKey
+	(*CreateAPIKeyRequest)(nil),                       // 75: gidyon.mpesa.b2c.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                      // 76: gidyon.mpesa.b2c.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                        // 77: gidyon.mpesa.b2c.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                       // 78: gidyon.mpesa.b2c.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),                       // 79: gidyon.mpesa.b2c.RevokeAPIKeyRequest
+	nil,                                               // 80: gidyon.mpesa.b2c.PublishInfo.PayloadEntry
+	(*field_mask.FieldMask)(nil),                      // 81: google.protobuf.FieldMask
+	(*emptypb.Empty)(nil),                             // 82: google.protobuf.Empty
+}
+var file_b2c_v1_proto_depIdxs = []int32{
+	0,  // 0: gidyon.mpesa.b2c.TransferFundsRequest.command_id:type_name -> gidyon.mpesa.b2c.CommandId
+	22, // 1: gidyon.mpesa.b2c.TransferFundsRequest.publish_message:type_name -> gidyon.mpesa.b2c.PublishInfo
+	22, // 2: gidyon.mpesa.b2c.PublishMessage.publish_info:type_name -> gidyon.mpesa.b2c.PublishInfo
+	23, // 3: gidyon.mpesa.b2c.PublishMessage.payment:type_name -> gidyon.mpesa.b2c.B2CPayment
+	13, // 4: gidyon.mpesa.b2c.PublishMessage.event_type:type_name -> gidyon.mpesa.b2c.B2CEventType
+	80, // 5: gidyon.mpesa.b2c.PublishInfo.payload:type_name -> gidyon.mpesa.b2c.PublishInfo.PayloadEntry
+	1,  // 6: gidyon.mpesa.b2c.PublishInfo.transport:type_name -> gidyon.mpesa.b2c.PublishTransport
+	13, // 7: gidyon.mpesa.b2c.PublishInfo.event_types:type_name -> gidyon.mpesa.b2c.B2CEventType
+	0,  // 8: gidyon.mpesa.b2c.B2CPayment.command_id:type_name -> gidyon.mpesa.b2c.CommandId
+	2,  // 9: gidyon.mpesa.b2c.B2CPayment.b2c_status:type_name -> gidyon.mpesa.b2c.B2CStatus
+	3,  // 10: gidyon.mpesa.b2c.GetB2CPaymentRequest.view:type_name -> gidyon.mpesa.b2c.B2CPaymentView
+	81, // 11: gidyon.mpesa.b2c.GetB2CPaymentRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 12: gidyon.mpesa.b2c.ListB2CPaymentFilter.b2c_statuses:type_name -> gidyon.mpesa.b2c.B2CStatus
+	8,  // 13: gidyon.mpesa.b2c.ListB2CPaymentFilter.process_state:type_name -> gidyon.mpesa.b2c.B2CProcessedState
+	4,  // 14: gidyon.mpesa.b2c.ListB2CPaymentFilter.order_field:type_name -> gidyon.mpesa.b2c.B2COrderField
+	5,  // 15: gidyon.mpesa.b2c.ListB2CPaymentFilter.order_direction:type_name -> gidyon.mpesa.b2c.B2COrderDirection
+	0,  // 16: gidyon.mpesa.b2c.ListB2CPaymentFilter.command_ids:type_name -> gidyon.mpesa.b2c.CommandId
+	6,  // 17: gidyon.mpesa.b2c.ListB2CPaymentFilter.succeeded_state:type_name -> gidyon.mpesa.b2c.B2CSucceededState
+	7,  // 18: gidyon.mpesa.b2c.ListB2CPaymentFilter.recipient_state:type_name -> gidyon.mpesa.b2c.B2CRecipientState
+	3,  // 19: gidyon.mpesa.b2c.ListB2CPaymentsRequest.view:type_name -> gidyon.mpesa.b2c.B2CPaymentView
+	25, // 20: gidyon.mpesa.b2c.ListB2CPaymentsRequest.filter:type_name -> gidyon.mpesa.b2c.ListB2CPaymentFilter
+	81, // 21: gidyon.mpesa.b2c.ListB2CPaymentsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	23, // 22: gidyon.mpesa.b2c.ListB2CPaymentsResponse.b2c_payments:type_name -> gidyon.mpesa.b2c.B2CPayment
+	25, // 23: gidyon.mpesa.b2c.AggregatePaymentsRequest.filter:type_name -> gidyon.mpesa.b2c.ListB2CPaymentFilter
+	9,  // 24: gidyon.mpesa.b2c.AggregatePaymentsRequest.group_by:type_name -> gidyon.mpesa.b2c.AggregateGroupBy
+	10, // 25: gidyon.mpesa.b2c.AggregatePaymentsRequest.time_bucket:type_name -> gidyon.mpesa.b2c.TimeBucket
+	0,  // 26: gidyon.mpesa.b2c.PaymentAggregate.command_id:type_name -> gidyon.mpesa.b2c.CommandId
+	2,  // 27: gidyon.mpesa.b2c.PaymentAggregate.b2c_status:type_name -> gidyon.mpesa.b2c.B2CStatus
+	29, // 28: gidyon.mpesa.b2c.AggregatePaymentsResponse.aggregates:type_name -> gidyon.mpesa.b2c.PaymentAggregate
+	25, // 29: gidyon.mpesa.b2c.ExportB2CPaymentsRequest.filter:type_name -> gidyon.mpesa.b2c.ListB2CPaymentFilter
+	11, // 30: gidyon.mpesa.b2c.ExportB2CPaymentsRequest.format:type_name -> gidyon.mpesa.b2c.ExportFormat
+	25, // 31: gidyon.mpesa.b2c.WatchB2CPaymentsRequest.filter:type_name -> gidyon.mpesa.b2c.ListB2CPaymentFilter
+	23, // 32: gidyon.mpesa.b2c.WatchB2CPaymentsResponse.payment:type_name -> gidyon.mpesa.b2c.B2CPayment
+	21, // 33: gidyon.mpesa.b2c.PublishB2CPaymentRequest.publish_message:type_name -> gidyon.mpesa.b2c.PublishMessage
+	8,  // 34: gidyon.mpesa.b2c.PublishB2CPaymentRequest.processed_state:type_name -> gidyon.mpesa.b2c.B2CProcessedState
+	38, // 35: gidyon.mpesa.b2c.DailyStat.command_stats:type_name -> gidyon.mpesa.b2c.CommandIdStat
+	0,  // 36: gidyon.mpesa.b2c.CommandIdStat.command_id:type_name -> gidyon.mpesa.b2c.CommandId
+	37, // 37: gidyon.mpesa.b2c.StatsResponse.stats:type_name -> gidyon.mpesa.b2c.DailyStat
+	40, // 38: gidyon.mpesa.b2c.ListDailyStatsRequest.filter:type_name -> gidyon.mpesa.b2c.ListStatsFilter
+	40, // 39: gidyon.mpesa.b2c.ListHourlyStatsRequest.filter:type_name -> gidyon.mpesa.b2c.ListStatsFilter
+	42, // 40: gidyon.mpesa.b2c.ListHourlyStatsResponse.stats:type_name -> gidyon.mpesa.b2c.HourlyStat
+	40, // 41: gidyon.mpesa.b2c.ListMonthlyStatsRequest.filter:type_name -> gidyon.mpesa.b2c.ListStatsFilter
+	45, // 42: gidyon.mpesa.b2c.ListMonthlyStatsResponse.stats:type_name -> gidyon.mpesa.b2c.MonthlyStat
+	12, // 43: gidyon.mpesa.b2c.StatsJob.status:type_name -> gidyon.mpesa.b2c.StatsJobStatus
+	17, // 44: gidyon.mpesa.b2c.QueryTransactionStatusRequest.identifier_type:type_name -> gidyon.mpesa.b2c.QueryTransactionStatusRequest.IdentifierType
+	18, // 45: gidyon.mpesa.b2c.QueryAccountBalanceRequest.identifier_type:type_name -> gidyon.mpesa.b2c.QueryAccountBalanceRequest.IdentifierType
+	13, // 46: gidyon.mpesa.b2c.WebhookSubscription.event_types:type_name -> gidyon.mpesa.b2c.B2CEventType
+	56, // 47: gidyon.mpesa.b2c.CreateWebhookSubscriptionRequest.subscription:type_name -> gidyon.mpesa.b2c.WebhookSubscription
+	56, // 48: gidyon.mpesa.b2c.ListWebhookSubscriptionsResponse.subscriptions:type_name -> gidyon.mpesa.b2c.WebhookSubscription
+	13, // 49: gidyon.mpesa.b2c.WebhookDelivery.event_type:type_name -> gidyon.mpesa.b2c.B2CEventType
+	14, // 50: gidyon.mpesa.b2c.WebhookDelivery.status:type_name -> gidyon.mpesa.b2c.WebhookDeliveryStatus
+	14, // 51: gidyon.mpesa.b2c.ListWebhookDeliveriesRequest.statuses:type_name -> gidyon.mpesa.b2c.WebhookDeliveryStatus
+	61, // 52: gidyon.mpesa.b2c.ListWebhookDeliveriesResponse.deliveries:type_name -> gidyon.mpesa.b2c.WebhookDelivery
+	66, // 53: gidyon.mpesa.b2c.StreamGroupInfo.consumers:type_name -> gidyon.mpesa.b2c.StreamConsumerInfo
+	67, // 54: gidyon.mpesa.b2c.PublishStreamInfo.groups:type_name -> gidyon.mpesa.b2c.StreamGroupInfo
+	15, // 55: gidyon.mpesa.b2c.ArchivalRun.status:type_name -> gidyon.mpesa.b2c.ArchivalRunStatus
+	16, // 56: gidyon.mpesa.b2c.ArchivalRun.target:type_name -> gidyon.mpesa.b2c.ArchiveTarget
+	15, // 57: gidyon.mpesa.b2c.ListArchivalRunsRequest.statuses:type_name -> gidyon.mpesa.b2c.ArchivalRunStatus
+	70, // 58: gidyon.mpesa.b2c.ListArchivalRunsResponse.runs:type_name -> gidyon.mpesa.b2c.ArchivalRun
+	74, // 59: gidyon.mpesa.b2c.CreateAPIKeyRequest.api_key:type_name -> gidyon.mpesa.b2c.APIKey
+	74, // 60: gidyon.mpesa.b2c.CreateAPIKeyResponse.api_key:type_name -> gidyon.mpesa.b2c.APIKey
+	74, // 61: gidyon.mpesa.b2c.ListAPIKeysResponse.api_keys:type_name -> gidyon.mpesa.b2c.APIKey
+	19, // 62: gidyon.mpesa.b2c.B2CV1.TransferFunds:input_type -> gidyon.mpesa.b2c.TransferFundsRequest
+	24, // 63: gidyon.mpesa.b2c.B2CV1.GetB2CPayment:input_type -> gidyon.mpesa.b2c.GetB2CPaymentRequest
+	26, // 64: gidyon.mpesa.b2c.B2CV1.ListB2CPayments:input_type -> gidyon.mpesa.b2c.ListB2CPaymentsRequest
+	33, // 65: gidyon.mpesa.b2c.B2CV1.WatchB2CPayments:input_type -> gidyon.mpesa.b2c.WatchB2CPaymentsRequest
+	31, // 66: gidyon.mpesa.b2c.B2CV1.ExportB2CPayments:input_type -> gidyon.mpesa.b2c.ExportB2CPaymentsRequest
+	35, // 67: gidyon.mpesa.b2c.B2CV1.ProcessB2CPayment:input_type -> gidyon.mpesa.b2c.ProcessB2CPaymentRequest
+	36, // 68: gidyon.mpesa.b2c.B2CV1.PublishB2CPayment:input_type -> gidyon.mpesa.b2c.PublishB2CPaymentRequest
+	41, // 69: gidyon.mpesa.b2c.B2CV1.ListDailyStats:input_type -> gidyon.mpesa.b2c.ListDailyStatsRequest
+	43, // 70: gidyon.mpesa.b2c.B2CV1.ListHourlyStats:input_type -> gidyon.mpesa.b2c.ListHourlyStatsRequest
+	46, // 71: gidyon.mpesa.b2c.B2CV1.ListMonthlyStats:input_type -> gidyon.mpesa.b2c.ListMonthlyStatsRequest
+	48, // 72: gidyon.mpesa.b2c.B2CV1.RegenerateStats:input_type -> gidyon.mpesa.b2c.RegenerateStatsRequest
+	50, // 73: gidyon.mpesa.b2c.B2CV1.GetStatsJob:input_type -> gidyon.mpesa.b2c.GetStatsJobRequest
+	28, // 74: gidyon.mpesa.b2c.B2CV1.AggregatePayments:input_type -> gidyon.mpesa.b2c.AggregatePaymentsRequest
+	51, // 75: gidyon.mpesa.b2c.B2CV1.QueryTransactionStatus:input_type -> gidyon.mpesa.b2c.QueryTransactionStatusRequest
+	53, // 76: gidyon.mpesa.b2c.B2CV1.QueryAccountBalance:input_type -> gidyon.mpesa.b2c.QueryAccountBalanceRequest
+	55, // 77: gidyon.mpesa.b2c.B2CV1.ReverseTransaction:input_type -> gidyon.mpesa.b2c.ReverseTransactionRequest
+	57, // 78: gidyon.mpesa.b2c.B2CV1.CreateWebhookSubscription:input_type -> gidyon.mpesa.b2c.CreateWebhookSubscriptionRequest
+	58, // 79: gidyon.mpesa.b2c.B2CV1.ListWebhookSubscriptions:input_type -> gidyon.mpesa.b2c.ListWebhookSubscriptionsRequest
+	60, // 80: gidyon.mpesa.b2c.B2CV1.DeleteWebhookSubscription:input_type -> gidyon.mpesa.b2c.DeleteWebhookSubscriptionRequest
+	62, // 81: gidyon.mpesa.b2c.B2CV1.ListWebhookDeliveries:input_type -> gidyon.mpesa.b2c.ListWebhookDeliveriesRequest
+	64, // 82: gidyon.mpesa.b2c.B2CV1.RedeliverWebhook:input_type -> gidyon.mpesa.b2c.RedeliverWebhookRequest
+	65, // 83: gidyon.mpesa.b2c.B2CV1.GetPublishStreamInfo:input_type -> gidyon.mpesa.b2c.GetPublishStreamInfoRequest
+	69, // 84: gidyon.mpesa.b2c.B2CV1.ArchivePayments:input_type -> gidyon.mpesa.b2c.ArchivePaymentsRequest
+	71, // 85: gidyon.mpesa.b2c.B2CV1.GetArchivalRun:input_type -> gidyon.mpesa.b2c.GetArchivalRunRequest
+	72, // 86: gidyon.mpesa.b2c.B2CV1.ListArchivalRuns:input_type -> gidyon.mpesa.b2c.ListArchivalRunsRequest
+	75, // 87: gidyon.mpesa.b2c.B2CV1.CreateAPIKey:input_type -> gidyon.mpesa.b2c.CreateAPIKeyRequest
+	77, // 88: gidyon.mpesa.b2c.B2CV1.ListAPIKeys:input_type -> gidyon.mpesa.b2c.ListAPIKeysRequest
+	79, // 89: gidyon.mpesa.b2c.B2CV1.RevokeAPIKey:input_type -> gidyon.mpesa.b2c.RevokeAPIKeyRequest
+	20, // 90: gidyon.mpesa.b2c.B2CV1.TransferFunds:output_type -> gidyon.mpesa.b2c.TransferFundsResponse
+	23, // 91: gidyon.mpesa.b2c.B2CV1.GetB2CPayment:output_type -> gidyon.mpesa.b2c.B2CPayment
+	27, // 92: gidyon.mpesa.b2c.B2CV1.ListB2CPayments:output_type -> gidyon.mpesa.b2c.ListB2CPaymentsResponse
+	34, // 93: gidyon.mpesa.b2c.B2CV1.WatchB2CPayments:output_type -> gidyon.mpesa.b2c.WatchB2CPaymentsResponse
+	32, // 94: gidyon.mpesa.b2c.B2CV1.ExportB2CPayments:output_type -> gidyon.mpesa.b2c.ExportB2CPaymentsChunk
+	82, // 95: gidyon.mpesa.b2c.B2CV1.ProcessB2CPayment:output_type -> google.protobuf.Empty
+	82, // 96: gidyon.mpesa.b2c.B2CV1.PublishB2CPayment:output_type -> google.protobuf.Empty
+	39, // 97: gidyon.mpesa.b2c.B2CV1.ListDailyStats:output_type -> gidyon.mpesa.b2c.StatsResponse
+	44, // 98: gidyon.mpesa.b2c.B2CV1.ListHourlyStats:output_type -> gidyon.mpesa.b2c.ListHourlyStatsResponse
+	47, // 99: gidyon.mpesa.b2c.B2CV1.ListMonthlyStats:output_type -> gidyon.mpesa.b2c.ListMonthlyStatsResponse
+	49, // 100: gidyon.mpesa.b2c.B2CV1.RegenerateStats:output_type -> gidyon.mpesa.b2c.StatsJob
+	49, // 101: gidyon.mpesa.b2c.B2CV1.GetStatsJob:output_type -> gidyon.mpesa.b2c.StatsJob
+	30, // 102: gidyon.mpesa.b2c.B2CV1.AggregatePayments:output_type -> gidyon.mpesa.b2c.AggregatePaymentsResponse
+	52, // 103: gidyon.mpesa.b2c.B2CV1.QueryTransactionStatus:output_type -> gidyon.mpesa.b2c.QueryResponse
+	54, // 104: gidyon.mpesa.b2c.B2CV1.QueryAccountBalance:output_type -> gidyon.mpesa.b2c.QueryAccountBalanceResponse
+	82, // 105: gidyon.mpesa.b2c.B2CV1.ReverseTransaction:output_type -> google.protobuf.Empty
+	56, // 106: gidyon.mpesa.b2c.B2CV1.CreateWebhookSubscription:output_type -> gidyon.mpesa.b2c.WebhookSubscription
+	59, // 107: gidyon.mpesa.b2c.B2CV1.ListWebhookSubscriptions:output_type -> gidyon.mpesa.b2c.ListWebhookSubscriptionsResponse
+	82, // 108: gidyon.mpesa.b2c.B2CV1.DeleteWebhookSubscription:output_type -> google.protobuf.Empty
+	63, // 109: gidyon.mpesa.b2c.B2CV1.ListWebhookDeliveries:output_type -> gidyon.mpesa.b2c.ListWebhookDeliveriesResponse
+	61, // 110: gidyon.mpesa.b2c.B2CV1.RedeliverWebhook:output_type -> gidyon.mpesa.b2c.WebhookDelivery
+	68, // 111: gidyon.mpesa.b2c.B2CV1.GetPublishStreamInfo:output_type -> gidyon.mpesa.b2c.PublishStreamInfo
+	70, // 112: gidyon.mpesa.b2c.B2CV1.ArchivePayments:output_type -> gidyon.mpesa.b2c.ArchivalRun
+	70, // 113: gidyon.mpesa.b2c.B2CV1.GetArchivalRun:output_type -> gidyon.mpesa.b2c.ArchivalRun
+	73, // 114: gidyon.mpesa.b2c.B2CV1.ListArchivalRuns:output_type -> gidyon.mpesa.b2c.ListArchivalRunsResponse
+	76, // 115: gidyon.mpesa.b2c.B2CV1.CreateAPIKey:output_type -> gidyon.mpesa.b2c.CreateAPIKeyResponse
+	78, // 116: gidyon.mpesa.b2c.B2CV1.ListAPIKeys:output_type -> gidyon.mpesa.b2c.ListAPIKeysResponse
+	74, // 117: gidyon.mpesa.b2c.B2CV1.RevokeAPIKey:output_type -> gidyon.mpesa.b2c.APIKey
+	90, // [90:118] is the sub-list for method output_type
+	62, // [62:90] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
+}
+
+func init() { file_b2c_v1_proto_init() }
+func file_b2c_v1_proto_init() {
+	if File_b2c_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_b2c_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFundsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferFundsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*B2CPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetB2CPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListB2CPaymentFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListB2CPaymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListB2CPaymentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregatePaymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentAggregate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregatePaymentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportB2CPaymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportB2CPaymentsChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchB2CPaymentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchB2CPaymentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessB2CPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishB2CPaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandIdStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStatsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDailyStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HourlyStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHourlyStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHourlyStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonthlyStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMonthlyStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMonthlyStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTransactionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAccountBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAccountBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_b2c_v1_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookSubscriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2946,8 +8524,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransferFundsResponse); i {
+		file_b2c_v1_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2958,8 +8536,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishMessage); i {
+		file_b2c_v1_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2970,8 +8548,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishInfo); i {
+		file_b2c_v1_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2982,8 +8560,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*B2CPayment); i {
+		file_b2c_v1_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2994,8 +8572,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetB2CPaymentRequest); i {
+		file_b2c_v1_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublishStreamInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3006,8 +8584,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListB2CPaymentFilter); i {
+		file_b2c_v1_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamConsumerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3018,8 +8596,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListB2CPaymentsRequest); i {
+		file_b2c_v1_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamGroupInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3030,8 +8608,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListB2CPaymentsResponse); i {
+		file_b2c_v1_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishStreamInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3042,8 +8620,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProcessB2CPaymentRequest); i {
+		file_b2c_v1_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivePaymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3054,8 +8632,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishB2CPaymentRequest); i {
+		file_b2c_v1_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivalRun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3066,8 +8644,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DailyStat); i {
+		file_b2c_v1_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivalRunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3078,8 +8656,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsResponse); i {
+		file_b2c_v1_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArchivalRunsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3090,8 +8668,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStatsFilter); i {
+		file_b2c_v1_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListArchivalRunsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3102,8 +8680,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDailyStatsRequest); i {
+		file_b2c_v1_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3114,8 +8692,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTransactionStatusRequest); i {
+		file_b2c_v1_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3126,8 +8704,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryResponse); i {
+		file_b2c_v1_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAPIKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3138,8 +8716,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAccountBalanceRequest); i {
+		file_b2c_v1_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3150,8 +8728,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAccountBalanceResponse); i {
+		file_b2c_v1_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAPIKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3162,8 +8740,8 @@ func file_b2c_v1_proto_init() {
 				return nil
 			}
 		}
-		file_b2c_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReverseTransactionRequest); i {
+		file_b2c_v1_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeAPIKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3180,8 +8758,8 @@ func file_b2c_v1_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_b2c_v1_proto_rawDesc,
-			NumEnums:      7,
-			NumMessages:   21,
+			NumEnums:      19,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -12,6 +12,7 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
 const _ = grpc.SupportPackageIsVersion7
 
 // B2CV1Client is the client API for B2CV1 service.
@@ -24,18 +25,56 @@ type B2CV1Client interface {
 	GetB2CPayment(ctx context.Context, in *GetB2CPaymentRequest, opts ...grpc.CallOption) (*B2CPayment, error)
 	// Retrieves a collection of b2c payments
 	ListB2CPayments(ctx context.Context, in *ListB2CPaymentsRequest, opts ...grpc.CallOption) (*ListB2CPaymentsResponse, error)
+	// Streams b2c payment updates matching the filter as they happen
+	WatchB2CPayments(ctx context.Context, in *WatchB2CPaymentsRequest, opts ...grpc.CallOption) (B2CV1_WatchB2CPaymentsClient, error)
+	// Exports b2c payments matching the filter as a CSV or XLSX file streamed in chunks
+	ExportB2CPayments(ctx context.Context, in *ExportB2CPaymentsRequest, opts ...grpc.CallOption) (B2CV1_ExportB2CPaymentsClient, error)
 	// Processes b2c payment updating its status
 	ProcessB2CPayment(ctx context.Context, in *ProcessB2CPaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Publishes b2c payment to consumers
 	PublishB2CPayment(ctx context.Context, in *PublishB2CPaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Retrieves a collection of statistics
 	ListDailyStats(ctx context.Context, in *ListDailyStatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	// Retrieves a collection of hourly statistics
+	ListHourlyStats(ctx context.Context, in *ListHourlyStatsRequest, opts ...grpc.CallOption) (*ListHourlyStatsResponse, error)
+	// Retrieves a collection of monthly statistics
+	ListMonthlyStats(ctx context.Context, in *ListMonthlyStatsRequest, opts ...grpc.CallOption) (*ListMonthlyStatsResponse, error)
+	// Recomputes statistics for a date range as a background job
+	RegenerateStats(ctx context.Context, in *RegenerateStatsRequest, opts ...grpc.CallOption) (*StatsJob, error)
+	// Retrieves a statistics regeneration job
+	GetStatsJob(ctx context.Context, in *GetStatsJobRequest, opts ...grpc.CallOption) (*StatsJob, error)
+	// Aggregates payments matching the filter by the requested groups
+	AggregatePayments(ctx context.Context, in *AggregatePaymentsRequest, opts ...grpc.CallOption) (*AggregatePaymentsResponse, error)
 	// Queries for query transaction
 	QueryTransactionStatus(ctx context.Context, in *QueryTransactionStatusRequest, opts ...grpc.CallOption) (*QueryResponse, error)
 	// Queries for account balance
 	QueryAccountBalance(ctx context.Context, in *QueryAccountBalanceRequest, opts ...grpc.CallOption) (*QueryAccountBalanceResponse, error)
 	// Reverses an mpesa transaction
 	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates a webhook subscription for an initiator
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	// Retrieves a collection of webhook subscriptions
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	// Deletes a webhook subscription
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Retrieves a collection of webhook deliveries
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// Schedules a webhook delivery to be sent again
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// Retrieves consumer groups, pending entries and lag for a publish stream
+	GetPublishStreamInfo(ctx context.Context, in *GetPublishStreamInfoRequest, opts ...grpc.CallOption) (*PublishStreamInfo, error)
+	// Moves payments older than the retention out of the payments table
+	ArchivePayments(ctx context.Context, in *ArchivePaymentsRequest, opts ...grpc.CallOption) (*ArchivalRun, error)
+	// Retrieves a payments archival run
+	GetArchivalRun(ctx context.Context, in *GetArchivalRunRequest, opts ...grpc.CallOption) (*ArchivalRun, error)
+	// Retrieves a collection of payments archival runs
+	ListArchivalRuns(ctx context.Context, in *ListArchivalRunsRequest, opts ...grpc.CallOption) (*ListArchivalRunsResponse, error)
+	// Creates an API key for a service caller; the key is only returned here
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// Retrieves a collection of API keys
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	// Revokes an API key
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
 }
 
 type b2CV1Client struct {
@@ -73,6 +112,70 @@ func (c *b2CV1Client) ListB2CPayments(ctx context.Context, in *ListB2CPaymentsRe
 	return out, nil
 }
 
+func (c *b2CV1Client) WatchB2CPayments(ctx context.Context, in *WatchB2CPaymentsRequest, opts ...grpc.CallOption) (B2CV1_WatchB2CPaymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &B2CV1_ServiceDesc.Streams[0], "/gidyon.mpesa.b2c.B2CV1/WatchB2CPayments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &b2CV1WatchB2CPaymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type B2CV1_WatchB2CPaymentsClient interface {
+	Recv() (*WatchB2CPaymentsResponse, error)
+	grpc.ClientStream
+}
+
+type b2CV1WatchB2CPaymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *b2CV1WatchB2CPaymentsClient) Recv() (*WatchB2CPaymentsResponse, error) {
+	m := new(WatchB2CPaymentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *b2CV1Client) ExportB2CPayments(ctx context.Context, in *ExportB2CPaymentsRequest, opts ...grpc.CallOption) (B2CV1_ExportB2CPaymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &B2CV1_ServiceDesc.Streams[1], "/gidyon.mpesa.b2c.B2CV1/ExportB2CPayments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &b2CV1ExportB2CPaymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type B2CV1_ExportB2CPaymentsClient interface {
+	Recv() (*ExportB2CPaymentsChunk, error)
+	grpc.ClientStream
+}
+
+type b2CV1ExportB2CPaymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *b2CV1ExportB2CPaymentsClient) Recv() (*ExportB2CPaymentsChunk, error) {
+	m := new(ExportB2CPaymentsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *b2CV1Client) ProcessB2CPayment(ctx context.Context, in *ProcessB2CPaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ProcessB2CPayment", in, out, opts...)
@@ -100,6 +203,51 @@ func (c *b2CV1Client) ListDailyStats(ctx context.Context, in *ListDailyStatsRequ
 	return out, nil
 }
 
+func (c *b2CV1Client) ListHourlyStats(ctx context.Context, in *ListHourlyStatsRequest, opts ...grpc.CallOption) (*ListHourlyStatsResponse, error) {
+	out := new(ListHourlyStatsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ListHourlyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) ListMonthlyStats(ctx context.Context, in *ListMonthlyStatsRequest, opts ...grpc.CallOption) (*ListMonthlyStatsResponse, error) {
+	out := new(ListMonthlyStatsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ListMonthlyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) RegenerateStats(ctx context.Context, in *RegenerateStatsRequest, opts ...grpc.CallOption) (*StatsJob, error) {
+	out := new(StatsJob)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/RegenerateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) GetStatsJob(ctx context.Context, in *GetStatsJobRequest, opts ...grpc.CallOption) (*StatsJob, error) {
+	out := new(StatsJob)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/GetStatsJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) AggregatePayments(ctx context.Context, in *AggregatePaymentsRequest, opts ...grpc.CallOption) (*AggregatePaymentsResponse, error) {
+	out := new(AggregatePaymentsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/AggregatePayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *b2CV1Client) QueryTransactionStatus(ctx context.Context, in *QueryTransactionStatusRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
 	out := new(QueryResponse)
 	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/QueryTransactionStatus", in, out, opts...)
@@ -127,6 +275,114 @@ func (c *b2CV1Client) ReverseTransaction(ctx context.Context, in *ReverseTransac
 	return out, nil
 }
 
+func (c *b2CV1Client) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/CreateWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ListWebhookSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/DeleteWebhookSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/RedeliverWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) GetPublishStreamInfo(ctx context.Context, in *GetPublishStreamInfoRequest, opts ...grpc.CallOption) (*PublishStreamInfo, error) {
+	out := new(PublishStreamInfo)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/GetPublishStreamInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) ArchivePayments(ctx context.Context, in *ArchivePaymentsRequest, opts ...grpc.CallOption) (*ArchivalRun, error) {
+	out := new(ArchivalRun)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ArchivePayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) GetArchivalRun(ctx context.Context, in *GetArchivalRunRequest, opts ...grpc.CallOption) (*ArchivalRun, error) {
+	out := new(ArchivalRun)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/GetArchivalRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) ListArchivalRuns(ctx context.Context, in *ListArchivalRunsRequest, opts ...grpc.CallOption) (*ListArchivalRunsResponse, error) {
+	out := new(ListArchivalRunsResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ListArchivalRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *b2CV1Client) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, "/gidyon.mpesa.b2c.B2CV1/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // B2CV1Server is the server API for B2CV1 service.
 // All implementations must embed UnimplementedB2CV1Server
 // for forward compatibility
@@ -137,18 +393,56 @@ type B2CV1Server interface {
 	GetB2CPayment(context.Context, *GetB2CPaymentRequest) (*B2CPayment, error)
 	// Retrieves a collection of b2c payments
 	ListB2CPayments(context.Context, *ListB2CPaymentsRequest) (*ListB2CPaymentsResponse, error)
+	// Streams b2c payment updates matching the filter as they happen
+	WatchB2CPayments(*WatchB2CPaymentsRequest, B2CV1_WatchB2CPaymentsServer) error
+	// Exports b2c payments matching the filter as a CSV or XLSX file streamed in chunks
+	ExportB2CPayments(*ExportB2CPaymentsRequest, B2CV1_ExportB2CPaymentsServer) error
 	// Processes b2c payment updating its status
 	ProcessB2CPayment(context.Context, *ProcessB2CPaymentRequest) (*emptypb.Empty, error)
 	// Publishes b2c payment to consumers
 	PublishB2CPayment(context.Context, *PublishB2CPaymentRequest) (*emptypb.Empty, error)
 	// Retrieves a collection of statistics
 	ListDailyStats(context.Context, *ListDailyStatsRequest) (*StatsResponse, error)
+	// Retrieves a collection of hourly statistics
+	ListHourlyStats(context.Context, *ListHourlyStatsRequest) (*ListHourlyStatsResponse, error)
+	// Retrieves a collection of monthly statistics
+	ListMonthlyStats(context.Context, *ListMonthlyStatsRequest) (*ListMonthlyStatsResponse, error)
+	// Recomputes statistics for a date range as a background job
+	RegenerateStats(context.Context, *RegenerateStatsRequest) (*StatsJob, error)
+	// Retrieves a statistics regeneration job
+	GetStatsJob(context.Context, *GetStatsJobRequest) (*StatsJob, error)
+	// Aggregates payments matching the filter by the requested groups
+	AggregatePayments(context.Context, *AggregatePaymentsRequest) (*AggregatePaymentsResponse, error)
 	// Queries for query transaction
 	QueryTransactionStatus(context.Context, *QueryTransactionStatusRequest) (*QueryResponse, error)
 	// Queries for account balance
 	QueryAccountBalance(context.Context, *QueryAccountBalanceRequest) (*QueryAccountBalanceResponse, error)
 	// Reverses an mpesa transaction
 	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*emptypb.Empty, error)
+	// Creates a webhook subscription for an initiator
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	// Retrieves a collection of webhook subscriptions
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	// Deletes a webhook subscription
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error)
+	// Retrieves a collection of webhook deliveries
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// Schedules a webhook delivery to be sent again
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error)
+	// Retrieves consumer groups, pending entries and lag for a publish stream
+	GetPublishStreamInfo(context.Context, *GetPublishStreamInfoRequest) (*PublishStreamInfo, error)
+	// Moves payments older than the retention out of the payments table
+	ArchivePayments(context.Context, *ArchivePaymentsRequest) (*ArchivalRun, error)
+	// Retrieves a payments archival run
+	GetArchivalRun(context.Context, *GetArchivalRunRequest) (*ArchivalRun, error)
+	// Retrieves a collection of payments archival runs
+	ListArchivalRuns(context.Context, *ListArchivalRunsRequest) (*ListArchivalRunsResponse, error)
+	// Creates an API key for a service caller; the key is only returned here
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// Retrieves a collection of API keys
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	// Revokes an API key
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error)
 	mustEmbedUnimplementedB2CV1Server()
 }
 
@@ -165,6 +459,12 @@ func (UnimplementedB2CV1Server) GetB2CPayment(context.Context, *GetB2CPaymentReq
 func (UnimplementedB2CV1Server) ListB2CPayments(context.Context, *ListB2CPaymentsRequest) (*ListB2CPaymentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListB2CPayments not implemented")
 }
+func (UnimplementedB2CV1Server) WatchB2CPayments(*WatchB2CPaymentsRequest, B2CV1_WatchB2CPaymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchB2CPayments not implemented")
+}
+func (UnimplementedB2CV1Server) ExportB2CPayments(*ExportB2CPaymentsRequest, B2CV1_ExportB2CPaymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportB2CPayments not implemented")
+}
 func (UnimplementedB2CV1Server) ProcessB2CPayment(context.Context, *ProcessB2CPaymentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessB2CPayment not implemented")
 }
@@ -174,6 +474,21 @@ func (UnimplementedB2CV1Server) PublishB2CPayment(context.Context, *PublishB2CPa
 func (UnimplementedB2CV1Server) ListDailyStats(context.Context, *ListDailyStatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDailyStats not implemented")
 }
+func (UnimplementedB2CV1Server) ListHourlyStats(context.Context, *ListHourlyStatsRequest) (*ListHourlyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHourlyStats not implemented")
+}
+func (UnimplementedB2CV1Server) ListMonthlyStats(context.Context, *ListMonthlyStatsRequest) (*ListMonthlyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMonthlyStats not implemented")
+}
+func (UnimplementedB2CV1Server) RegenerateStats(context.Context, *RegenerateStatsRequest) (*StatsJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateStats not implemented")
+}
+func (UnimplementedB2CV1Server) GetStatsJob(context.Context, *GetStatsJobRequest) (*StatsJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatsJob not implemented")
+}
+func (UnimplementedB2CV1Server) AggregatePayments(context.Context, *AggregatePaymentsRequest) (*AggregatePaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePayments not implemented")
+}
 func (UnimplementedB2CV1Server) QueryTransactionStatus(context.Context, *QueryTransactionStatusRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTransactionStatus not implemented")
 }
@@ -183,6 +498,42 @@ func (UnimplementedB2CV1Server) QueryAccountBalance(context.Context, *QueryAccou
 func (UnimplementedB2CV1Server) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
 }
+func (UnimplementedB2CV1Server) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedB2CV1Server) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedB2CV1Server) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedB2CV1Server) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedB2CV1Server) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedB2CV1Server) GetPublishStreamInfo(context.Context, *GetPublishStreamInfoRequest) (*PublishStreamInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublishStreamInfo not implemented")
+}
+func (UnimplementedB2CV1Server) ArchivePayments(context.Context, *ArchivePaymentsRequest) (*ArchivalRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePayments not implemented")
+}
+func (UnimplementedB2CV1Server) GetArchivalRun(context.Context, *GetArchivalRunRequest) (*ArchivalRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchivalRun not implemented")
+}
+func (UnimplementedB2CV1Server) ListArchivalRuns(context.Context, *ListArchivalRunsRequest) (*ListArchivalRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArchivalRuns not implemented")
+}
+func (UnimplementedB2CV1Server) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedB2CV1Server) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedB2CV1Server) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
 func (UnimplementedB2CV1Server) mustEmbedUnimplementedB2CV1Server() {}
 
 // UnsafeB2CV1Server may be embedded to opt out of forward compatibility for this service.
@@ -193,7 +544,7 @@ type UnsafeB2CV1Server interface {
 }
 
 func RegisterB2CV1Server(s grpc.ServiceRegistrar, srv B2CV1Server) {
-	s.RegisterService(&_B2CV1_serviceDesc, srv)
+	s.RegisterService(&B2CV1_ServiceDesc, srv)
 }
 
 func _B2CV1_TransferFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -250,6 +601,48 @@ func _B2CV1_ListB2CPayments_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _B2CV1_WatchB2CPayments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchB2CPaymentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(B2CV1Server).WatchB2CPayments(m, &b2CV1WatchB2CPaymentsServer{stream})
+}
+
+type B2CV1_WatchB2CPaymentsServer interface {
+	Send(*WatchB2CPaymentsResponse) error
+	grpc.ServerStream
+}
+
+type b2CV1WatchB2CPaymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *b2CV1WatchB2CPaymentsServer) Send(m *WatchB2CPaymentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _B2CV1_ExportB2CPayments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportB2CPaymentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(B2CV1Server).ExportB2CPayments(m, &b2CV1ExportB2CPaymentsServer{stream})
+}
+
+type B2CV1_ExportB2CPaymentsServer interface {
+	Send(*ExportB2CPaymentsChunk) error
+	grpc.ServerStream
+}
+
+type b2CV1ExportB2CPaymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *b2CV1ExportB2CPaymentsServer) Send(m *ExportB2CPaymentsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _B2CV1_ProcessB2CPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ProcessB2CPaymentRequest)
 	if err := dec(in); err != nil {
@@ -304,6 +697,96 @@ func _B2CV1_ListDailyStats_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _B2CV1_ListHourlyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHourlyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ListHourlyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ListHourlyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ListHourlyStats(ctx, req.(*ListHourlyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_ListMonthlyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMonthlyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ListMonthlyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ListMonthlyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ListMonthlyStats(ctx, req.(*ListMonthlyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_RegenerateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).RegenerateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/RegenerateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).RegenerateStats(ctx, req.(*RegenerateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_GetStatsJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).GetStatsJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/GetStatsJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).GetStatsJob(ctx, req.(*GetStatsJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_AggregatePayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatePaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).AggregatePayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/AggregatePayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).AggregatePayments(ctx, req.(*AggregatePaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _B2CV1_QueryTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTransactionStatusRequest)
 	if err := dec(in); err != nil {
@@ -358,7 +841,226 @@ func _B2CV1_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _B2CV1_serviceDesc = grpc.ServiceDesc{
+func _B2CV1_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/CreateWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ListWebhookSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/DeleteWebhookSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).RedeliverWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/RedeliverWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_GetPublishStreamInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublishStreamInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).GetPublishStreamInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/GetPublishStreamInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).GetPublishStreamInfo(ctx, req.(*GetPublishStreamInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_ArchivePayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ArchivePayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ArchivePayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ArchivePayments(ctx, req.(*ArchivePaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_GetArchivalRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchivalRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).GetArchivalRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/GetArchivalRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).GetArchivalRun(ctx, req.(*GetArchivalRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_ListArchivalRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArchivalRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ListArchivalRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ListArchivalRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ListArchivalRuns(ctx, req.(*ListArchivalRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _B2CV1_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(B2CV1Server).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gidyon.mpesa.b2c.B2CV1/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(B2CV1Server).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// B2CV1_ServiceDesc is the grpc.ServiceDesc for B2CV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var B2CV1_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "gidyon.mpesa.b2c.B2CV1",
 	HandlerType: (*B2CV1Server)(nil),
 	Methods: []grpc.MethodDesc{
@@ -386,6 +1088,26 @@ var _B2CV1_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListDailyStats",
 			Handler:    _B2CV1_ListDailyStats_Handler,
 		},
+		{
+			MethodName: "ListHourlyStats",
+			Handler:    _B2CV1_ListHourlyStats_Handler,
+		},
+		{
+			MethodName: "ListMonthlyStats",
+			Handler:    _B2CV1_ListMonthlyStats_Handler,
+		},
+		{
+			MethodName: "RegenerateStats",
+			Handler:    _B2CV1_RegenerateStats_Handler,
+		},
+		{
+			MethodName: "GetStatsJob",
+			Handler:    _B2CV1_GetStatsJob_Handler,
+		},
+		{
+			MethodName: "AggregatePayments",
+			Handler:    _B2CV1_AggregatePayments_Handler,
+		},
 		{
 			MethodName: "QueryTransactionStatus",
 			Handler:    _B2CV1_QueryTransactionStatus_Handler,
@@ -398,7 +1120,66 @@ var _B2CV1_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseTransaction",
 			Handler:    _B2CV1_ReverseTransaction_Handler,
 		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _B2CV1_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _B2CV1_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _B2CV1_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _B2CV1_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _B2CV1_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "GetPublishStreamInfo",
+			Handler:    _B2CV1_GetPublishStreamInfo_Handler,
+		},
+		{
+			MethodName: "ArchivePayments",
+			Handler:    _B2CV1_ArchivePayments_Handler,
+		},
+		{
+			MethodName: "GetArchivalRun",
+			Handler:    _B2CV1_GetArchivalRun_Handler,
+		},
+		{
+			MethodName: "ListArchivalRuns",
+			Handler:    _B2CV1_ListArchivalRuns_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _B2CV1_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _B2CV1_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _B2CV1_RevokeAPIKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchB2CPayments",
+			Handler:       _B2CV1_WatchB2CPayments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportB2CPayments",
+			Handler:       _B2CV1_ExportB2CPayments_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "b2c.v1.proto",
 }
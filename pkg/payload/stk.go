@@ -3,6 +3,8 @@ package payload
 import (
 	"fmt"
 	"time"
+
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
 )
 
 // STKPayload is incoming transaction payload for stk push
@@ -132,17 +134,8 @@ func getTransactionTime(transactionTimeStr string) (time.Time, error) {
 		return time.Now(), nil
 	}
 
-	timeRFC3339Str := fmt.Sprintf(
-		"%s-%s-%sT%s:%s:%s+00:00",
-		transactionTimeStr[:4],    // year
-		transactionTimeStr[4:6],   // month
-		transactionTimeStr[6:8],   // day
-		transactionTimeStr[8:10],  // hour
-		transactionTimeStr[10:12], // minutes
-		transactionTimeStr[12:],   // seconds
-	)
-
-	return time.Parse(time.RFC3339, timeRFC3339Str)
+	// Mpesa timestamps have no offset; they are in the reporting timezone
+	return timeutil.ParseReportingTime("20060102150405", transactionTimeStr)
 }
 
 // {
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/gidyon/mpesa-b2c/pkg/utils/timeutil"
 )
 
 // Transaction is response from mpesa
@@ -137,17 +139,8 @@ func getTransactionTimev2(transactionTimeStr string) (time.Time, error) {
 		return time.Now(), nil
 	}
 
-	timeRFC3339Str := fmt.Sprintf(
-		"%s-%s-%sT%s:%s:%s+00:00",
-		transactionTimeStr[6:10],  // year
-		transactionTimeStr[3:5],   // month
-		transactionTimeStr[:2],    // day
-		transactionTimeStr[11:13], // hour
-		transactionTimeStr[14:16], // minutes
-		transactionTimeStr[17:19], // seconds
-	)
-
-	return time.Parse(time.RFC3339, timeRFC3339Str)
+	// Mpesa timestamps have no offset; they are in the reporting timezone
+	return timeutil.ParseReportingTime("02.01.2006 15:04:05", transactionTimeStr)
 }
 
 // TransactionCompletedDateTime is time the transacction was completed
@@ -0,0 +1,226 @@
+// Package pubstream contains helpers for consuming b2c payments appended to redis streams
+package pubstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	b2c "github.com/gidyon/mpesa-b2c/pkg/api/b2c/v1"
+	"github.com/go-redis/redis/v8"
+	"google.golang.org/protobuf/proto"
+)
+
+// MessageField is the stream entry field holding the protobuf encoded PublishMessage
+const MessageField = "message"
+
+// Key returns the redis stream key for a publish channel
+func Key(channel string) string {
+	return fmt.Sprintf("b2cstream:%s", channel)
+}
+
+// DeadLetterKey returns the key of the stream holding entries of a stream that could not be decoded
+func DeadLetterKey(stream string) string {
+	return stream + ":dead"
+}
+
+// IsNoGroup reports whether err is redis reporting that the stream or consumer group does not exist
+func IsNoGroup(err error) bool {
+	return err != nil && strings.HasPrefix(err.Error(), "NOGROUP")
+}
+
+// CreateGroup creates the consumer group on the stream, creating the stream if it does not exist.
+//
+// The group starts from the first entry in the stream so that retained entries are not skipped.
+func CreateGroup(ctx context.Context, redisDB *redis.Client, stream, group string) error {
+	err := redisDB.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
+	}
+	return nil
+}
+
+// Message is a publish message read from a stream
+type Message struct {
+	ID             string
+	PublishMessage *b2c.PublishMessage
+}
+
+// Consumer reads publish messages from a stream as a member of a consumer group
+type Consumer struct {
+	redisDB *redis.Client
+	stream  string
+	group   string
+	name    string
+}
+
+// NewConsumer creates a consumer named name in group for the publish channel
+func NewConsumer(ctx context.Context, redisDB *redis.Client, channel, group, name string) (*Consumer, error) {
+	switch {
+	case redisDB == nil:
+		return nil, errors.New("missing redis db")
+	case channel == "":
+		return nil, errors.New("missing channel name")
+	case group == "":
+		return nil, errors.New("missing group name")
+	case name == "":
+		return nil, errors.New("missing consumer name")
+	}
+
+	c := &Consumer{
+		redisDB: redisDB,
+		stream:  Key(channel),
+		group:   group,
+		name:    name,
+	}
+
+	err := CreateGroup(ctx, redisDB, c.stream, c.group)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create consumer group: %v", err)
+	}
+
+	return c, nil
+}
+
+// Read reads up to count new messages, waiting up to block for messages to arrive.
+//
+// Messages must be acknowledged with Ack once processed otherwise they remain pending. Entries that cannot be
+// decoded are moved to the dead letter stream instead of being returned. The consumer group is created again
+// when the stream was deleted.
+func (c *Consumer) Read(ctx context.Context, count int64, block time.Duration) ([]*Message, error) {
+	args := &redis.XReadGroupArgs{
+		Group:    c.group,
+		Consumer: c.name,
+		Streams:  []string{c.stream, ">"},
+		Count:    count,
+		Block:    block,
+	}
+
+	res, err := c.redisDB.XReadGroup(ctx, args).Result()
+	if IsNoGroup(err) {
+		err = CreateGroup(ctx, c.redisDB, c.stream, c.group)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create consumer group: %v", err)
+		}
+		res, err = c.redisDB.XReadGroup(ctx, args).Result()
+	}
+	switch {
+	case err == nil:
+	case errors.Is(err, redis.Nil):
+		return []*Message{}, nil
+	default:
+		return nil, err
+	}
+
+	xmsgs := make([]redis.XMessage, 0, count)
+	for _, stream := range res {
+		xmsgs = append(xmsgs, stream.Messages...)
+	}
+
+	return c.decode(ctx, xmsgs)
+}
+
+// Ack acknowledges messages removing them from the pending entries list
+func (c *Consumer) Ack(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return c.redisDB.XAck(ctx, c.stream, c.group, ids...).Err()
+}
+
+// Reclaim takes ownership of up to count messages that consumers in the group, this one included, have left pending
+// for at least minIdle.
+//
+// Use it on startup or periodically to recover messages from consumers that crashed before acknowledging.
+// The idle filter of XPENDING needs redis 6.2 or later.
+func (c *Consumer) Reclaim(ctx context.Context, minIdle time.Duration, count int64) ([]*Message, error) {
+	// Recent pending messages must not hide older ones
+	pending, err := c.redisDB.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: c.stream,
+		Group:  c.group,
+		Idle:   minIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  count,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(pending))
+	for _, entry := range pending {
+		ids = append(ids, entry.ID)
+	}
+	if len(ids) == 0 {
+		return []*Message{}, nil
+	}
+
+	xmsgs, err := c.redisDB.XClaim(ctx, &redis.XClaimArgs{
+		Stream:   c.stream,
+		Group:    c.group,
+		Consumer: c.name,
+		MinIdle:  minIdle,
+		Messages: ids,
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decode(ctx, xmsgs)
+}
+
+// decode decodes stream entries, moving those that cannot be decoded to the dead letter stream
+func (c *Consumer) decode(ctx context.Context, xmsgs []redis.XMessage) ([]*Message, error) {
+	msgs := make([]*Message, 0, len(xmsgs))
+	for _, xmsg := range xmsgs {
+		msg, err := decode(xmsg)
+		if err != nil {
+			err = c.deadLetter(ctx, xmsg, err)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// deadLetter copies the entry to the dead letter stream and acknowledges it so that it is not delivered again
+func (c *Consumer) deadLetter(ctx context.Context, xmsg redis.XMessage, decodeErr error) error {
+	values := make(map[string]interface{}, len(xmsg.Values)+3)
+	for k, v := range xmsg.Values {
+		values[k] = v
+	}
+	values["dead_letter_id"] = xmsg.ID
+	values["dead_letter_group"] = c.group
+	values["dead_letter_error"] = decodeErr.Error()
+
+	_, err := c.redisDB.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.XAdd(ctx, &redis.XAddArgs{Stream: DeadLetterKey(c.stream), Values: values})
+		pipe.XAck(ctx, c.stream, c.group, xmsg.ID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to dead letter stream entry %s: %v", xmsg.ID, err)
+	}
+
+	return nil
+}
+
+func decode(xmsg redis.XMessage) (*Message, error) {
+	v, ok := xmsg.Values[MessageField]
+	if !ok {
+		return nil, fmt.Errorf("stream entry %s is missing %s field", xmsg.ID, MessageField)
+	}
+
+	pb := &b2c.PublishMessage{}
+	err := proto.Unmarshal([]byte(fmt.Sprint(v)), pb)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stream entry %s: %v", xmsg.ID, err)
+	}
+
+	return &Message{ID: xmsg.ID, PublishMessage: pb}, nil
+}
@@ -0,0 +1,243 @@
+package httputils
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DebugHeader is the header that turns on debug logging for a request
+const DebugHeader = "X-Debug-Log"
+
+const defaultMaxBodyBytes = 4096
+
+type debugCtxKey struct{}
+
+// WithDebug returns a context whose http traffic is always logged with bodies
+func WithDebug(ctx context.Context) context.Context {
+	return context.WithValue(ctx, debugCtxKey{}, true)
+}
+
+// IsDebug reports whether debug logging was turned on for the context
+func IsDebug(ctx context.Context) bool {
+	debug, _ := ctx.Value(debugCtxKey{}).(bool)
+	return debug
+}
+
+// LoggerOptions configures logging of http traffic
+type LoggerOptions struct {
+	Logger *zap.Logger
+	// Rules for redacting logged traffic; DefaultRedactRules is used when nil
+	Rules *RedactRules
+	// SampleRate is the fraction of successful exchanges that are logged; failed exchanges are always logged
+	SampleRate float64
+	// LogBodies includes bodies in sampled entries; bodies are always included for failed or debug exchanges
+	LogBodies bool
+	// MaxBodyBytes truncates logged bodies; defaults to 4096
+	MaxBodyBytes int
+	// AllowDebugHeader honours the DebugHeader of inbound requests; leave it off where callers are not trusted
+	AllowDebugHeader bool
+}
+
+type httpLogger struct {
+	*LoggerOptions
+}
+
+func newHTTPLogger(opt *LoggerOptions) *httpLogger {
+	o := *opt
+	if o.Logger == nil {
+		o.Logger = zap.NewNop()
+	}
+	if o.Rules == nil {
+		o.Rules = DefaultRedactRules()
+	}
+	if o.MaxBodyBytes <= 0 {
+		o.MaxBodyBytes = defaultMaxBodyBytes
+	}
+	return &httpLogger{LoggerOptions: &o}
+}
+
+func (l *httpLogger) sampled() bool {
+	return l.SampleRate >= 1 || (l.SampleRate > 0 && rand.Float64() < l.SampleRate)
+}
+
+// body redacts before truncating since truncated JSON can no longer be parsed for secrets
+func (l *httpLogger) body(bs []byte) string {
+	s := l.Rules.RedactBody(bs)
+	if len(s) > l.MaxBodyBytes {
+		return s[:l.MaxBodyBytes] + "...(truncated)"
+	}
+	return s
+}
+
+func (l *httpLogger) log(msg string, failed bool, fields []zap.Field) {
+	if failed {
+		l.Logger.Warn(msg, fields...)
+		return
+	}
+	l.Logger.Info(msg, fields...)
+}
+
+// readBody reads the body and returns a replacement for it
+func readBody(rc io.ReadCloser) ([]byte, io.ReadCloser, error) {
+	if rc == nil || rc == http.NoBody {
+		return nil, rc, nil
+	}
+	bs, err := io.ReadAll(rc)
+	rc.Close()
+	return bs, io.NopCloser(bytes.NewReader(bs)), err
+}
+
+// NewLoggingTransport wraps the round tripper so that outbound requests and their responses are logged
+func NewLoggingTransport(base http.RoundTripper, opt *LoggerOptions) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &loggingTransport{base: base, httpLogger: newHTTPLogger(opt)}
+}
+
+type loggingTransport struct {
+	base http.RoundTripper
+	*httpLogger
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		debug   = IsDebug(req.Context())
+		sampled = debug || t.sampled()
+		start   = time.Now()
+	)
+
+	res, err := t.base.RoundTrip(req)
+
+	failed := err != nil || res.StatusCode >= http.StatusBadRequest
+	if !sampled && !failed {
+		return res, err
+	}
+
+	fields := []zap.Field{
+		zap.String("method", req.Method),
+		zap.String("url", t.Rules.RedactURL(req.URL)),
+		zap.Duration("duration", time.Since(start)),
+		zap.Any("request_headers", t.Rules.RedactHeaders(req.Header)),
+	}
+
+	withBodies := debug || failed || t.LogBodies
+
+	// Requests built from in-memory readers can be read again
+	if withBodies && req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			bs, _, _ := readBody(rc)
+			fields = append(fields, zap.String("request_body", t.body(bs)))
+		}
+	}
+
+	if err != nil {
+		fields = append(fields, zap.Error(err))
+		t.log("http client request failed", true, fields)
+		return res, err
+	}
+
+	fields = append(fields,
+		zap.Int("status", res.StatusCode),
+		zap.Any("response_headers", t.Rules.RedactHeaders(res.Header)),
+	)
+
+	if withBodies {
+		bs, body, rerr := readBody(res.Body)
+		res.Body = body
+		if rerr != nil {
+			return nil, rerr
+		}
+		fields = append(fields, zap.String("response_body", t.body(bs)))
+	}
+
+	t.log("http client request", failed, fields)
+
+	return res, nil
+}
+
+// LoggingHandler wraps the handler so that inbound requests are logged.
+//
+// When AllowDebugHeader is set, sending the DebugHeader with a value of true turns on debug logging
+// for the request and for outbound requests made with its context.
+func LoggingHandler(h http.Handler, opt *LoggerOptions) http.Handler {
+	l := newHTTPLogger(opt)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var debug bool
+		if l.AllowDebugHeader {
+			debug, _ = strconv.ParseBool(r.Header.Get(DebugHeader))
+		}
+		if debug {
+			r = r.WithContext(WithDebug(r.Context()))
+		}
+
+		sampled := debug || l.sampled()
+
+		bs, body, err := readBody(r.Body)
+		if err != nil {
+			l.Logger.Warn("failed to read http request body", zap.String("url", l.Rules.RedactURL(r.URL)), zap.Error(err))
+			http.Error(w, "failed to read request body", http.StatusBadRequest)
+			return
+		}
+		r.Body = body
+
+		rw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+
+		h.ServeHTTP(rw, r)
+
+		failed := rw.status >= http.StatusBadRequest
+		if !sampled && !failed {
+			return
+		}
+
+		fields := []zap.Field{
+			zap.String("method", r.Method),
+			zap.String("url", l.Rules.RedactURL(r.URL)),
+			zap.String("remote_addr", r.RemoteAddr),
+			zap.Int("status", rw.status),
+			zap.Duration("duration", time.Since(start)),
+			zap.Any("request_headers", l.Rules.RedactHeaders(r.Header)),
+		}
+
+		if debug || failed || l.LogBodies {
+			fields = append(fields, zap.String("request_body", l.body(bs)))
+		}
+
+		l.log("http server request", failed, fields)
+	})
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+	wrote  bool
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	if !w.wrote {
+		w.status = status
+		w.wrote = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *statusWriter) Write(bs []byte) (int, error) {
+	w.wrote = true
+	return w.ResponseWriter.Write(bs)
+}
+
+// Flush lets streaming handlers flush through the writer
+func (w *statusWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
@@ -0,0 +1,163 @@
+package httputils
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Redacted replaces values removed from logs
+const Redacted = "[REDACTED]"
+
+// msisdnPattern matches kenyan mobile numbers in local and international formats
+var msisdnPattern = regexp.MustCompile(`(?:\+254|\b254|\b0|\b)[17]\d{8}\b`)
+
+// RedactRules configures what is removed from logged http traffic
+type RedactRules struct {
+	// Headers whose values are redacted; matched case-insensitively
+	Headers []string
+	// JSON fields and query parameters whose values are redacted at any depth; matched case-insensitively.
+	//
+	// Mpesa result parameters given as {"Key": name, "Value": value} are redacted when the key matches.
+	Fields []string
+	// MaskMsisdn masks phone numbers in bodies and query strings leaving the last three digits
+	MaskMsisdn bool
+}
+
+// DefaultRedactRules redacts credentials, tokens, recipient names and phone numbers
+func DefaultRedactRules() *RedactRules {
+	return &RedactRules{
+		Headers: []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"},
+		Fields: []string{
+			"SecurityCredential",
+			"Password",
+			"access_token",
+			"token",
+			"secret",
+			"ReceiverPartyPublicName",
+		},
+		MaskMsisdn: true,
+	}
+}
+
+func (rules *RedactRules) redactField(name string) bool {
+	for _, field := range rules.Fields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (rules *RedactRules) maskMsisdn(s string) string {
+	if !rules.MaskMsisdn {
+		return s
+	}
+	return msisdnPattern.ReplaceAllStringFunc(s, func(v string) string {
+		return strings.Repeat("*", len(v)-3) + v[len(v)-3:]
+	})
+}
+
+// RedactHeaders returns a copy of the headers with redacted values
+func (rules *RedactRules) RedactHeaders(header http.Header) map[string]string {
+	vals := make(map[string]string, len(header))
+	for key := range header {
+		redact := false
+		for _, name := range rules.Headers {
+			if strings.EqualFold(name, key) {
+				redact = true
+				break
+			}
+		}
+		if redact {
+			vals[key] = Redacted
+			continue
+		}
+		vals[key] = rules.maskMsisdn(header.Get(key))
+	}
+	return vals
+}
+
+// RedactURL returns the url with redacted query parameters
+func (rules *RedactRules) RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	query := u.Query()
+	for key, vals := range query {
+		for i := range vals {
+			if rules.redactField(key) {
+				vals[i] = Redacted
+			} else {
+				vals[i] = rules.maskMsisdn(vals[i])
+			}
+		}
+	}
+	v := *u
+	v.RawQuery = query.Encode()
+	return v.String()
+}
+
+// RedactBody returns the body with redacted values; bodies that are not JSON only have phone numbers masked
+func (rules *RedactRules) RedactBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	err := json.Unmarshal(body, &v)
+	if err != nil {
+		return rules.maskMsisdn(string(body))
+	}
+
+	bs, err := json.Marshal(rules.redactValue(v))
+	if err != nil {
+		return Redacted
+	}
+	return string(bs)
+}
+
+func (rules *RedactRules) redactValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		// Mpesa result parameters
+		if key, ok := val["Key"].(string); ok && rules.redactField(key) {
+			if _, ok := val["Value"]; ok {
+				val["Value"] = Redacted
+			}
+		}
+		for key, item := range val {
+			if rules.redactField(key) {
+				val[key] = Redacted
+				continue
+			}
+			val[key] = rules.redactValue(item)
+		}
+		return val
+	case []interface{}:
+		for i, item := range val {
+			val[i] = rules.redactValue(item)
+		}
+		return val
+	case string:
+		return rules.maskMsisdn(val)
+	case float64:
+		// Phone numbers are sent as numbers in some requests e.g. PartyB
+		if rules.MaskMsisdn && val == math.Trunc(val) {
+			s := strconv.FormatFloat(val, 'f', -1, 64)
+			if masked := rules.maskMsisdn(s); masked != s {
+				return masked
+			}
+		}
+		return val
+	}
+	return v
+}
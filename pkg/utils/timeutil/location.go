@@ -0,0 +1,66 @@
+package timeutil
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// DefaultReportingTimezone is the timezone mpesa timestamps are in and reports are generated for
+const DefaultReportingTimezone = "Africa/Nairobi"
+
+var reportingLocation atomic.Value
+
+// SetReportingLocation sets the location used to parse mpesa timestamps and compute day boundaries
+func SetReportingLocation(loc *time.Location) {
+	if loc == nil {
+		loc = defaultReportingLocation()
+	}
+	reportingLocation.Store(loc)
+}
+
+// ReportingLocation returns the reporting location; it defaults to Africa/Nairobi
+func ReportingLocation() *time.Location {
+	loc, ok := reportingLocation.Load().(*time.Location)
+	if !ok {
+		return defaultReportingLocation()
+	}
+	return loc
+}
+
+func defaultReportingLocation() *time.Location {
+	loc, err := time.LoadLocation(DefaultReportingTimezone)
+	if err != nil {
+		// East Africa Time has no daylight saving
+		return time.FixedZone("EAT", 3*60*60)
+	}
+	return loc
+}
+
+// DayStart returns the start of the day containing t in the reporting location
+func DayStart(t time.Time) time.Time {
+	t = t.In(ReportingLocation())
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// HourStart returns the start of the hour containing t in the reporting location
+func HourStart(t time.Time) time.Time {
+	t = t.In(ReportingLocation())
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+}
+
+// ParseReportingDate parses a date in the format 2006-01-02 to the start of the day in the reporting location
+func ParseReportingDate(date string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02", date, ReportingLocation())
+}
+
+// ParseReportingTime parses a wall clock time in the given layout in the reporting location
+func ParseReportingTime(layout, value string) (time.Time, error) {
+	return time.ParseInLocation(layout, value, ReportingLocation())
+}
+
+// InReportingLocation reinterprets the wall clock of t, ignoring its location, in the reporting location
+func InReportingLocation(t time.Time) time.Time {
+	return time.Date(
+		t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), ReportingLocation(),
+	)
+}